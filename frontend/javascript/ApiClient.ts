@@ -315,6 +315,25 @@ export class ApiClient {
     return klass.decode(new Uint8Array(await resp.arrayBuffer()));
   }
 
+  public async markNotificationsRead(
+    payload: xsuportal.proto.services.contestant.IMarkNotificationsReadRequest
+  ) {
+    const responseClass =
+      xsuportal.proto.services.contestant.MarkNotificationsReadResponse;
+    const payloadClass =
+      xsuportal.proto.services.contestant.MarkNotificationsReadRequest;
+    const payloadMessage = payloadClass
+      .encode(payloadClass.fromObject(payload))
+      .finish();
+    const resp = await this.request(
+      `${this.baseUrl}/api/contestant/notifications/read`,
+      "POST",
+      null,
+      payloadMessage
+    );
+    return responseClass.decode(new Uint8Array(await resp.arrayBuffer()));
+  }
+
   public async subscribeNotification(subscription: PushSubscription) {
     const responseClass =
       xsuportal.proto.services.contestant.SubscribeNotificationResponse;
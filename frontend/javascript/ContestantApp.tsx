@@ -174,14 +174,15 @@ export class ContestantApp extends React.Component<Props, State> {
     console.log({
       localNotificationEnabled: this.state.localNotificationEnabled,
     });
-    if (!this.state.localNotificationEnabled) return;
+    if (!this.state.localNotificationEnabled) return false;
     const worker = this.state.serviceWorker?.active;
     console.log({ worker: worker });
-    if (!worker) return;
+    if (!worker) return false;
     worker.postMessage({
       kind: "localNotification",
       notifications: notifications,
     });
+    return true;
   }
 
   public render() {
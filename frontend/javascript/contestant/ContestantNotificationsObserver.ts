@@ -9,9 +9,11 @@ export class ContestantNotificationsObserver {
   timer?: number;
 
   public onLastAnsweredClarificationIdChange?: (id: number | undefined) => any;
+  // Returns true when the notifications were shown to the user. Only shown
+  // notifications are marked as read.
   public onNewNotifications?: (
     notifications: xsuportal.proto.resources.INotification[]
-  ) => any;
+  ) => boolean;
 
   lastAnsweredClarificationId?: number;
 
@@ -57,12 +59,24 @@ export class ContestantNotificationsObserver {
 
       this.lastAnsweredClarificationId = lastAnsweredClarificationId;
 
-      if (resp.notifications.length > 0 && this.onNewNotifications) {
+      const unreadCount = resp.unreadCount as number;
+      // The first poll returns the latest notifications including read ones,
+      // so only the unread ones at the tail are new.
+      const newNotifications =
+        this.last === undefined
+          ? resp.notifications.slice(
+              Math.max(resp.notifications.length - unreadCount, 0)
+            )
+          : resp.notifications;
+      if (newNotifications.length > 0 && this.onNewNotifications) {
         console.log(
           "ContestantNotificationsObserver: observed newNotifications",
-          resp.notifications
+          newNotifications
         );
-        this.onNewNotifications(resp.notifications);
+        if (this.onNewNotifications(newNotifications)) {
+          const shown = newNotifications[newNotifications.length - 1];
+          await this.client.markNotificationsRead({ upTo: shown.id });
+        }
       }
 
       const last = resp.notifications[resp.notifications.length - 1];
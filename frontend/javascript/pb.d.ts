@@ -1312,6 +1312,18 @@ export namespace xsuportal {
 
                 /** Contest frozen */
                 frozen?: (boolean|null);
+
+                /** Contest id */
+                id?: (number|Long|null);
+
+                /** Contest name */
+                name?: (string|null);
+
+                /** Contest active */
+                active?: (boolean|null);
+
+                /** Contest archivedAt */
+                archivedAt?: (google.protobuf.ITimestamp|null);
             }
 
             /** Represents a Contest. */
@@ -1341,6 +1353,18 @@ export namespace xsuportal {
                 /** Contest frozen. */
                 public frozen: boolean;
 
+                /** Contest id. */
+                public id: (number|Long);
+
+                /** Contest name. */
+                public name: string;
+
+                /** Contest active. */
+                public active: boolean;
+
+                /** Contest archivedAt. */
+                public archivedAt?: (google.protobuf.ITimestamp|null);
+
                 /**
                  * Creates a new Contest instance using the specified properties.
                  * @param [properties] Properties to set
@@ -1883,6 +1907,9 @@ export namespace xsuportal {
 
                     /** BenchmarkJobMessage benchmarkJobId */
                     benchmarkJobId?: (number|Long|null);
+
+                    /** BenchmarkJobMessage requeued */
+                    requeued?: (boolean|null);
                 }
 
                 /** Represents a BenchmarkJobMessage. */
@@ -1897,6 +1924,9 @@ export namespace xsuportal {
                     /** BenchmarkJobMessage benchmarkJobId. */
                     public benchmarkJobId: (number|Long);
 
+                    /** BenchmarkJobMessage requeued. */
+                    public requeued: boolean;
+
                     /**
                      * Creates a new BenchmarkJobMessage instance using the specified properties.
                      * @param [properties] Properties to set
@@ -2169,6 +2199,12 @@ export namespace xsuportal {
 
                 /** Staff githubLogin */
                 githubLogin?: (string|null);
+
+                /** Staff contestantId */
+                contestantId?: (string|null);
+
+                /** Staff role */
+                role?: (string|null);
             }
 
             /** Represents a Staff. */
@@ -2186,6 +2222,12 @@ export namespace xsuportal {
                 /** Staff githubLogin. */
                 public githubLogin: string;
 
+                /** Staff contestantId. */
+                public contestantId: string;
+
+                /** Staff role. */
+                public role: string;
+
                 /**
                  * Creates a new Staff instance using the specified properties.
                  * @param [properties] Properties to set
@@ -2996,6 +3038,291 @@ export namespace xsuportal {
                     public toJSON(): { [k: string]: any };
                 }
 
+                /** Properties of a ListBenchmarkQueueWaitsRequest. */
+                interface IListBenchmarkQueueWaitsRequest {
+                }
+
+                /** Represents a ListBenchmarkQueueWaitsRequest. */
+                class ListBenchmarkQueueWaitsRequest implements IListBenchmarkQueueWaitsRequest {
+
+                    /**
+                     * Constructs a new ListBenchmarkQueueWaitsRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsRequest);
+
+                    /**
+                     * Creates a new ListBenchmarkQueueWaitsRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListBenchmarkQueueWaitsRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsRequest): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest;
+
+                    /**
+                     * Encodes the specified ListBenchmarkQueueWaitsRequest message. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest.verify|verify} messages.
+                     * @param message ListBenchmarkQueueWaitsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListBenchmarkQueueWaitsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest.verify|verify} messages.
+                     * @param message ListBenchmarkQueueWaitsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListBenchmarkQueueWaitsRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListBenchmarkQueueWaitsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest;
+
+                    /**
+                     * Decodes a ListBenchmarkQueueWaitsRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListBenchmarkQueueWaitsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest;
+
+                    /**
+                     * Verifies a ListBenchmarkQueueWaitsRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListBenchmarkQueueWaitsRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListBenchmarkQueueWaitsRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest;
+
+                    /**
+                     * Creates a plain object from a ListBenchmarkQueueWaitsRequest message. Also converts values to other types if specified.
+                     * @param message ListBenchmarkQueueWaitsRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListBenchmarkQueueWaitsRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ListBenchmarkQueueWaitsResponse. */
+                interface IListBenchmarkQueueWaitsResponse {
+
+                    /** ListBenchmarkQueueWaitsResponse teams */
+                    teams?: (xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait[]|null);
+                }
+
+                /** Represents a ListBenchmarkQueueWaitsResponse. */
+                class ListBenchmarkQueueWaitsResponse implements IListBenchmarkQueueWaitsResponse {
+
+                    /**
+                     * Constructs a new ListBenchmarkQueueWaitsResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsResponse);
+
+                    /** ListBenchmarkQueueWaitsResponse teams. */
+                    public teams: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait[];
+
+                    /**
+                     * Creates a new ListBenchmarkQueueWaitsResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListBenchmarkQueueWaitsResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsResponse): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse;
+
+                    /**
+                     * Encodes the specified ListBenchmarkQueueWaitsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.verify|verify} messages.
+                     * @param message ListBenchmarkQueueWaitsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListBenchmarkQueueWaitsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.verify|verify} messages.
+                     * @param message ListBenchmarkQueueWaitsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListBenchmarkQueueWaitsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListBenchmarkQueueWaitsResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListBenchmarkQueueWaitsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse;
+
+                    /**
+                     * Decodes a ListBenchmarkQueueWaitsResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListBenchmarkQueueWaitsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse;
+
+                    /**
+                     * Verifies a ListBenchmarkQueueWaitsResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListBenchmarkQueueWaitsResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListBenchmarkQueueWaitsResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse;
+
+                    /**
+                     * Creates a plain object from a ListBenchmarkQueueWaitsResponse message. Also converts values to other types if specified.
+                     * @param message ListBenchmarkQueueWaitsResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListBenchmarkQueueWaitsResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                namespace ListBenchmarkQueueWaitsResponse {
+
+                    /** Properties of a TeamQueueWait. */
+                    interface ITeamQueueWait {
+
+                        /** TeamQueueWait teamId */
+                        teamId?: (number|Long|null);
+
+                        /** TeamQueueWait count */
+                        count?: (number|Long|null);
+
+                        /** TeamQueueWait averageWaitMicroseconds */
+                        averageWaitMicroseconds?: (number|Long|null);
+
+                        /** TeamQueueWait maxWaitMicroseconds */
+                        maxWaitMicroseconds?: (number|Long|null);
+                    }
+
+                    /** Represents a TeamQueueWait. */
+                    class TeamQueueWait implements ITeamQueueWait {
+
+                        /**
+                         * Constructs a new TeamQueueWait.
+                         * @param [properties] Properties to set
+                         */
+                        constructor(properties?: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait);
+
+                        /** TeamQueueWait teamId. */
+                        public teamId: (number|Long);
+
+                        /** TeamQueueWait count. */
+                        public count: (number|Long);
+
+                        /** TeamQueueWait averageWaitMicroseconds. */
+                        public averageWaitMicroseconds: (number|Long);
+
+                        /** TeamQueueWait maxWaitMicroseconds. */
+                        public maxWaitMicroseconds: (number|Long);
+
+                        /**
+                         * Creates a new TeamQueueWait instance using the specified properties.
+                         * @param [properties] Properties to set
+                         * @returns TeamQueueWait instance
+                         */
+                        public static create(properties?: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait;
+
+                        /**
+                         * Encodes the specified TeamQueueWait message. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait.verify|verify} messages.
+                         * @param message TeamQueueWait message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encode(message: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Encodes the specified TeamQueueWait message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait.verify|verify} messages.
+                         * @param message TeamQueueWait message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encodeDelimited(message: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.ITeamQueueWait, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Decodes a TeamQueueWait message from the specified reader or buffer.
+                         * @param reader Reader or buffer to decode from
+                         * @param [length] Message length if known beforehand
+                         * @returns TeamQueueWait
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait;
+
+                        /**
+                         * Decodes a TeamQueueWait message from the specified reader or buffer, length delimited.
+                         * @param reader Reader or buffer to decode from
+                         * @returns TeamQueueWait
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait;
+
+                        /**
+                         * Verifies a TeamQueueWait message.
+                         * @param message Plain object to verify
+                         * @returns `null` if valid, otherwise the reason why it is not
+                         */
+                        public static verify(message: { [k: string]: any }): (string|null);
+
+                        /**
+                         * Creates a TeamQueueWait message from a plain object. Also converts values to their respective internal types.
+                         * @param object Plain object
+                         * @returns TeamQueueWait
+                         */
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait;
+
+                        /**
+                         * Creates a plain object from a TeamQueueWait message. Also converts values to other types if specified.
+                         * @param message TeamQueueWait
+                         * @param [options] Conversion options
+                         * @returns Plain object
+                         */
+                        public static toObject(message: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                        /**
+                         * Converts this TeamQueueWait to JSON.
+                         * @returns JSON object
+                         */
+                        public toJSON(): { [k: string]: any };
+                    }
+                }
+
                 /** Properties of a ListClarificationsRequest. */
                 interface IListClarificationsRequest {
 
@@ -3746,4151 +4073,6329 @@ export namespace xsuportal {
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a DashboardRequest. */
-                interface IDashboardRequest {
+                /** Properties of a Clock. */
+                interface IClock {
+
+                    /** Clock rehearsal */
+                    rehearsal?: (boolean|null);
+
+                    /** Clock now */
+                    now?: (google.protobuf.ITimestamp|null);
+
+                    /** Clock offsetSeconds */
+                    offsetSeconds?: (number|null);
+
+                    /** Clock fixedAt */
+                    fixedAt?: (google.protobuf.ITimestamp|null);
                 }
 
-                /** Represents a DashboardRequest. */
-                class DashboardRequest implements IDashboardRequest {
+                /** Represents a Clock. */
+                class Clock implements IClock {
 
                     /**
-                     * Constructs a new DashboardRequest.
+                     * Constructs a new Clock.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IDashboardRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.IClock);
+
+                    /** Clock rehearsal. */
+                    public rehearsal: boolean;
+
+                    /** Clock now. */
+                    public now?: (google.protobuf.ITimestamp|null);
+
+                    /** Clock offsetSeconds. */
+                    public offsetSeconds: number;
+
+                    /** Clock fixedAt. */
+                    public fixedAt?: (google.protobuf.ITimestamp|null);
 
                     /**
-                     * Creates a new DashboardRequest instance using the specified properties.
+                     * Creates a new Clock instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardRequest instance
+                     * @returns Clock instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IDashboardRequest): xsuportal.proto.services.admin.DashboardRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IClock): xsuportal.proto.services.admin.Clock;
 
                     /**
-                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.admin.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified Clock message. Does not implicitly {@link xsuportal.proto.services.admin.Clock.verify|verify} messages.
+                     * @param message Clock message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IClock, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified Clock message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.Clock.verify|verify} messages.
+                     * @param message Clock message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IClock, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer.
+                     * Decodes a Clock message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardRequest
+                     * @returns Clock
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.DashboardRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.Clock;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a Clock message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardRequest
+                     * @returns Clock
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.DashboardRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.Clock;
 
                     /**
-                     * Verifies a DashboardRequest message.
+                     * Verifies a Clock message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a Clock message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardRequest
+                     * @returns Clock
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.DashboardRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.Clock;
 
                     /**
-                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
-                     * @param message DashboardRequest
+                     * Creates a plain object from a Clock message. Also converts values to other types if specified.
+                     * @param message Clock
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.Clock, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardRequest to JSON.
+                     * Converts this Clock to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a DashboardResponse. */
-                interface IDashboardResponse {
+                /** Properties of a GetClockResponse. */
+                interface IGetClockResponse {
 
-                    /** DashboardResponse leaderboard */
-                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** GetClockResponse clock */
+                    clock?: (xsuportal.proto.services.admin.IClock|null);
                 }
 
-                /** Represents a DashboardResponse. */
-                class DashboardResponse implements IDashboardResponse {
+                /** Represents a GetClockResponse. */
+                class GetClockResponse implements IGetClockResponse {
 
                     /**
-                     * Constructs a new DashboardResponse.
+                     * Constructs a new GetClockResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IDashboardResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IGetClockResponse);
 
-                    /** DashboardResponse leaderboard. */
-                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** GetClockResponse clock. */
+                    public clock?: (xsuportal.proto.services.admin.IClock|null);
 
                     /**
-                     * Creates a new DashboardResponse instance using the specified properties.
+                     * Creates a new GetClockResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardResponse instance
+                     * @returns GetClockResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IDashboardResponse): xsuportal.proto.services.admin.DashboardResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IGetClockResponse): xsuportal.proto.services.admin.GetClockResponse;
 
                     /**
-                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.admin.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified GetClockResponse message. Does not implicitly {@link xsuportal.proto.services.admin.GetClockResponse.verify|verify} messages.
+                     * @param message GetClockResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IGetClockResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified GetClockResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.GetClockResponse.verify|verify} messages.
+                     * @param message GetClockResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IGetClockResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer.
+                     * Decodes a GetClockResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardResponse
+                     * @returns GetClockResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.DashboardResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.GetClockResponse;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a GetClockResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardResponse
+                     * @returns GetClockResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.DashboardResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.GetClockResponse;
 
                     /**
-                     * Verifies a DashboardResponse message.
+                     * Verifies a GetClockResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a GetClockResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardResponse
+                     * @returns GetClockResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.DashboardResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.GetClockResponse;
 
                     /**
-                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
-                     * @param message DashboardResponse
+                     * Creates a plain object from a GetClockResponse message. Also converts values to other types if specified.
+                     * @param message GetClockResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.GetClockResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardResponse to JSON.
+                     * Converts this GetClockResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an InitializeRequest. */
-                interface IInitializeRequest {
+                /** Properties of an UpdateClockRequest. */
+                interface IUpdateClockRequest {
 
-                    /** InitializeRequest contest */
-                    contest?: (xsuportal.proto.resources.IContest|null);
+                    /** UpdateClockRequest offsetSeconds */
+                    offsetSeconds?: (number|null);
+
+                    /** UpdateClockRequest fixedAt */
+                    fixedAt?: (google.protobuf.ITimestamp|null);
                 }
 
-                /** Represents an InitializeRequest. */
-                class InitializeRequest implements IInitializeRequest {
+                /** Represents an UpdateClockRequest. */
+                class UpdateClockRequest implements IUpdateClockRequest {
 
                     /**
-                     * Constructs a new InitializeRequest.
+                     * Constructs a new UpdateClockRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IInitializeRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateClockRequest);
 
-                    /** InitializeRequest contest. */
-                    public contest?: (xsuportal.proto.resources.IContest|null);
+                    /** UpdateClockRequest offsetSeconds. */
+                    public offsetSeconds: number;
+
+                    /** UpdateClockRequest fixedAt. */
+                    public fixedAt?: (google.protobuf.ITimestamp|null);
 
                     /**
-                     * Creates a new InitializeRequest instance using the specified properties.
+                     * Creates a new UpdateClockRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns InitializeRequest instance
+                     * @returns UpdateClockRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IInitializeRequest): xsuportal.proto.services.admin.InitializeRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateClockRequest): xsuportal.proto.services.admin.UpdateClockRequest;
 
                     /**
-                     * Encodes the specified InitializeRequest message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeRequest.verify|verify} messages.
-                     * @param message InitializeRequest message or plain object to encode
+                     * Encodes the specified UpdateClockRequest message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateClockRequest.verify|verify} messages.
+                     * @param message UpdateClockRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IInitializeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateClockRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified InitializeRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeRequest.verify|verify} messages.
-                     * @param message InitializeRequest message or plain object to encode
+                     * Encodes the specified UpdateClockRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateClockRequest.verify|verify} messages.
+                     * @param message UpdateClockRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IInitializeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateClockRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an InitializeRequest message from the specified reader or buffer.
+                     * Decodes an UpdateClockRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns InitializeRequest
+                     * @returns UpdateClockRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateClockRequest;
 
                     /**
-                     * Decodes an InitializeRequest message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateClockRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns InitializeRequest
+                     * @returns UpdateClockRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateClockRequest;
 
                     /**
-                     * Verifies an InitializeRequest message.
+                     * Verifies an UpdateClockRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an InitializeRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateClockRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns InitializeRequest
+                     * @returns UpdateClockRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateClockRequest;
 
                     /**
-                     * Creates a plain object from an InitializeRequest message. Also converts values to other types if specified.
-                     * @param message InitializeRequest
+                     * Creates a plain object from an UpdateClockRequest message. Also converts values to other types if specified.
+                     * @param message UpdateClockRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.InitializeRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateClockRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this InitializeRequest to JSON.
+                     * Converts this UpdateClockRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an InitializeResponse. */
-                interface IInitializeResponse {
-
-                    /** InitializeResponse language */
-                    language?: (string|null);
+                /** Properties of an UpdateClockResponse. */
+                interface IUpdateClockResponse {
 
-                    /** InitializeResponse benchmarkServer */
-                    benchmarkServer?: (xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer|null);
+                    /** UpdateClockResponse clock */
+                    clock?: (xsuportal.proto.services.admin.IClock|null);
                 }
 
-                /** Represents an InitializeResponse. */
-                class InitializeResponse implements IInitializeResponse {
+                /** Represents an UpdateClockResponse. */
+                class UpdateClockResponse implements IUpdateClockResponse {
 
                     /**
-                     * Constructs a new InitializeResponse.
+                     * Constructs a new UpdateClockResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IInitializeResponse);
-
-                    /** InitializeResponse language. */
-                    public language: string;
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateClockResponse);
 
-                    /** InitializeResponse benchmarkServer. */
-                    public benchmarkServer?: (xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer|null);
+                    /** UpdateClockResponse clock. */
+                    public clock?: (xsuportal.proto.services.admin.IClock|null);
 
                     /**
-                     * Creates a new InitializeResponse instance using the specified properties.
+                     * Creates a new UpdateClockResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns InitializeResponse instance
+                     * @returns UpdateClockResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IInitializeResponse): xsuportal.proto.services.admin.InitializeResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateClockResponse): xsuportal.proto.services.admin.UpdateClockResponse;
 
                     /**
-                     * Encodes the specified InitializeResponse message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.verify|verify} messages.
-                     * @param message InitializeResponse message or plain object to encode
+                     * Encodes the specified UpdateClockResponse message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateClockResponse.verify|verify} messages.
+                     * @param message UpdateClockResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IInitializeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateClockResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified InitializeResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.verify|verify} messages.
-                     * @param message InitializeResponse message or plain object to encode
+                     * Encodes the specified UpdateClockResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateClockResponse.verify|verify} messages.
+                     * @param message UpdateClockResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IInitializeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateClockResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an InitializeResponse message from the specified reader or buffer.
+                     * Decodes an UpdateClockResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns InitializeResponse
+                     * @returns UpdateClockResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateClockResponse;
 
                     /**
-                     * Decodes an InitializeResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateClockResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns InitializeResponse
+                     * @returns UpdateClockResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateClockResponse;
 
                     /**
-                     * Verifies an InitializeResponse message.
+                     * Verifies an UpdateClockResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an InitializeResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateClockResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns InitializeResponse
+                     * @returns UpdateClockResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateClockResponse;
 
                     /**
-                     * Creates a plain object from an InitializeResponse message. Also converts values to other types if specified.
-                     * @param message InitializeResponse
+                     * Creates a plain object from an UpdateClockResponse message. Also converts values to other types if specified.
+                     * @param message UpdateClockResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.InitializeResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateClockResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this InitializeResponse to JSON.
+                     * Converts this UpdateClockResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                namespace InitializeResponse {
-
-                    /** Properties of a BenchmarkServer. */
-                    interface IBenchmarkServer {
-
-                        /** BenchmarkServer host */
-                        host?: (string|null);
-
-                        /** BenchmarkServer port */
-                        port?: (number|Long|null);
-                    }
-
-                    /** Represents a BenchmarkServer. */
-                    class BenchmarkServer implements IBenchmarkServer {
-
-                        /**
-                         * Constructs a new BenchmarkServer.
-                         * @param [properties] Properties to set
-                         */
-                        constructor(properties?: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer);
-
-                        /** BenchmarkServer host. */
-                        public host: string;
-
-                        /** BenchmarkServer port. */
-                        public port: (number|Long);
-
-                        /**
-                         * Creates a new BenchmarkServer instance using the specified properties.
-                         * @param [properties] Properties to set
-                         * @returns BenchmarkServer instance
-                         */
-                        public static create(properties?: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
-
-                        /**
-                         * Encodes the specified BenchmarkServer message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer.verify|verify} messages.
-                         * @param message BenchmarkServer message or plain object to encode
-                         * @param [writer] Writer to encode to
-                         * @returns Writer
-                         */
-                        public static encode(message: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer, writer?: $protobuf.Writer): $protobuf.Writer;
-
-                        /**
-                         * Encodes the specified BenchmarkServer message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer.verify|verify} messages.
-                         * @param message BenchmarkServer message or plain object to encode
-                         * @param [writer] Writer to encode to
-                         * @returns Writer
-                         */
-                        public static encodeDelimited(message: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer, writer?: $protobuf.Writer): $protobuf.Writer;
-
-                        /**
-                         * Decodes a BenchmarkServer message from the specified reader or buffer.
-                         * @param reader Reader or buffer to decode from
-                         * @param [length] Message length if known beforehand
-                         * @returns BenchmarkServer
-                         * @throws {Error} If the payload is not a reader or valid buffer
-                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
-                         */
-                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
-
-                        /**
-                         * Decodes a BenchmarkServer message from the specified reader or buffer, length delimited.
-                         * @param reader Reader or buffer to decode from
-                         * @returns BenchmarkServer
-                         * @throws {Error} If the payload is not a reader or valid buffer
-                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
-                         */
-                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
-
-                        /**
-                         * Verifies a BenchmarkServer message.
-                         * @param message Plain object to verify
-                         * @returns `null` if valid, otherwise the reason why it is not
-                         */
-                        public static verify(message: { [k: string]: any }): (string|null);
-
-                        /**
-                         * Creates a BenchmarkServer message from a plain object. Also converts values to their respective internal types.
-                         * @param object Plain object
-                         * @returns BenchmarkServer
-                         */
-                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
-
-                        /**
-                         * Creates a plain object from a BenchmarkServer message. Also converts values to other types if specified.
-                         * @param message BenchmarkServer
-                         * @param [options] Conversion options
-                         * @returns Plain object
-                         */
-                        public static toObject(message: xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer, options?: $protobuf.IConversionOptions): { [k: string]: any };
-
-                        /**
-                         * Converts this BenchmarkServer to JSON.
-                         * @returns JSON object
-                         */
-                        public toJSON(): { [k: string]: any };
-                    }
-                }
+                /** Properties of a ListContestsResponse. */
+                interface IListContestsResponse {
 
-                /** Properties of a ListTeamsRequest. */
-                interface IListTeamsRequest {
+                    /** ListContestsResponse contests */
+                    contests?: (xsuportal.proto.resources.IContest[]|null);
                 }
 
-                /** Represents a ListTeamsRequest. */
-                class ListTeamsRequest implements IListTeamsRequest {
+                /** Represents a ListContestsResponse. */
+                class ListContestsResponse implements IListContestsResponse {
 
                     /**
-                     * Constructs a new ListTeamsRequest.
+                     * Constructs a new ListContestsResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IListTeamsRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.IListContestsResponse);
+
+                    /** ListContestsResponse contests. */
+                    public contests: xsuportal.proto.resources.IContest[];
 
                     /**
-                     * Creates a new ListTeamsRequest instance using the specified properties.
+                     * Creates a new ListContestsResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListTeamsRequest instance
+                     * @returns ListContestsResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IListTeamsRequest): xsuportal.proto.services.admin.ListTeamsRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IListContestsResponse): xsuportal.proto.services.admin.ListContestsResponse;
 
                     /**
-                     * Encodes the specified ListTeamsRequest message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsRequest.verify|verify} messages.
-                     * @param message ListTeamsRequest message or plain object to encode
+                     * Encodes the specified ListContestsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListContestsResponse.verify|verify} messages.
+                     * @param message ListContestsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IListTeamsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IListContestsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListTeamsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsRequest.verify|verify} messages.
-                     * @param message ListTeamsRequest message or plain object to encode
+                     * Encodes the specified ListContestsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListContestsResponse.verify|verify} messages.
+                     * @param message ListContestsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListTeamsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListContestsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListTeamsRequest message from the specified reader or buffer.
+                     * Decodes a ListContestsResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListTeamsRequest
+                     * @returns ListContestsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListContestsResponse;
 
                     /**
-                     * Decodes a ListTeamsRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a ListContestsResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListTeamsRequest
+                     * @returns ListContestsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListContestsResponse;
 
                     /**
-                     * Verifies a ListTeamsRequest message.
+                     * Verifies a ListContestsResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListTeamsRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListContestsResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListTeamsRequest
+                     * @returns ListContestsResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListContestsResponse;
 
                     /**
-                     * Creates a plain object from a ListTeamsRequest message. Also converts values to other types if specified.
-                     * @param message ListTeamsRequest
+                     * Creates a plain object from a ListContestsResponse message. Also converts values to other types if specified.
+                     * @param message ListContestsResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.ListTeamsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.ListContestsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListTeamsRequest to JSON.
+                     * Converts this ListContestsResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListTeamsResponse. */
-                interface IListTeamsResponse {
+                /** Properties of a CreateContestRequest. */
+                interface ICreateContestRequest {
 
-                    /** ListTeamsResponse teams */
-                    teams?: (xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem[]|null);
+                    /** CreateContestRequest contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
                 }
 
-                /** Represents a ListTeamsResponse. */
-                class ListTeamsResponse implements IListTeamsResponse {
+                /** Represents a CreateContestRequest. */
+                class CreateContestRequest implements ICreateContestRequest {
 
                     /**
-                     * Constructs a new ListTeamsResponse.
+                     * Constructs a new CreateContestRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IListTeamsResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.ICreateContestRequest);
 
-                    /** ListTeamsResponse teams. */
-                    public teams: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem[];
+                    /** CreateContestRequest contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
 
                     /**
-                     * Creates a new ListTeamsResponse instance using the specified properties.
+                     * Creates a new CreateContestRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListTeamsResponse instance
+                     * @returns CreateContestRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IListTeamsResponse): xsuportal.proto.services.admin.ListTeamsResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.ICreateContestRequest): xsuportal.proto.services.admin.CreateContestRequest;
 
                     /**
-                     * Encodes the specified ListTeamsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.verify|verify} messages.
-                     * @param message ListTeamsResponse message or plain object to encode
+                     * Encodes the specified CreateContestRequest message. Does not implicitly {@link xsuportal.proto.services.admin.CreateContestRequest.verify|verify} messages.
+                     * @param message CreateContestRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.ICreateContestRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListTeamsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.verify|verify} messages.
-                     * @param message ListTeamsResponse message or plain object to encode
+                     * Encodes the specified CreateContestRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.CreateContestRequest.verify|verify} messages.
+                     * @param message CreateContestRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.ICreateContestRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListTeamsResponse message from the specified reader or buffer.
+                     * Decodes a CreateContestRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListTeamsResponse
+                     * @returns CreateContestRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.CreateContestRequest;
 
                     /**
-                     * Decodes a ListTeamsResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a CreateContestRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListTeamsResponse
+                     * @returns CreateContestRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.CreateContestRequest;
 
                     /**
-                     * Verifies a ListTeamsResponse message.
+                     * Verifies a CreateContestRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListTeamsResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a CreateContestRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListTeamsResponse
+                     * @returns CreateContestRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.CreateContestRequest;
 
                     /**
-                     * Creates a plain object from a ListTeamsResponse message. Also converts values to other types if specified.
-                     * @param message ListTeamsResponse
+                     * Creates a plain object from a CreateContestRequest message. Also converts values to other types if specified.
+                     * @param message CreateContestRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.ListTeamsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.CreateContestRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListTeamsResponse to JSON.
+                     * Converts this CreateContestRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                namespace ListTeamsResponse {
+                /** Properties of a CreateContestResponse. */
+                interface ICreateContestResponse {
 
-                    /** Properties of a TeamListItem. */
-                    interface ITeamListItem {
+                    /** CreateContestResponse contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
+                }
 
-                        /** TeamListItem teamId */
-                        teamId?: (number|Long|null);
-
-                        /** TeamListItem name */
-                        name?: (string|null);
-
-                        /** TeamListItem memberNames */
-                        memberNames?: (string[]|null);
-
-                        /** TeamListItem isStudent */
-                        isStudent?: (boolean|null);
-
-                        /** TeamListItem withdrawn */
-                        withdrawn?: (boolean|null);
-                    }
-
-                    /** Represents a TeamListItem. */
-                    class TeamListItem implements ITeamListItem {
-
-                        /**
-                         * Constructs a new TeamListItem.
-                         * @param [properties] Properties to set
-                         */
-                        constructor(properties?: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem);
-
-                        /** TeamListItem teamId. */
-                        public teamId: (number|Long);
-
-                        /** TeamListItem name. */
-                        public name: string;
-
-                        /** TeamListItem memberNames. */
-                        public memberNames: string[];
-
-                        /** TeamListItem isStudent. */
-                        public isStudent: boolean;
-
-                        /** TeamListItem withdrawn. */
-                        public withdrawn: boolean;
-
-                        /**
-                         * Creates a new TeamListItem instance using the specified properties.
-                         * @param [properties] Properties to set
-                         * @returns TeamListItem instance
-                         */
-                        public static create(properties?: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
-
-                        /**
-                         * Encodes the specified TeamListItem message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem.verify|verify} messages.
-                         * @param message TeamListItem message or plain object to encode
-                         * @param [writer] Writer to encode to
-                         * @returns Writer
-                         */
-                        public static encode(message: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
-
-                        /**
-                         * Encodes the specified TeamListItem message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem.verify|verify} messages.
-                         * @param message TeamListItem message or plain object to encode
-                         * @param [writer] Writer to encode to
-                         * @returns Writer
-                         */
-                        public static encodeDelimited(message: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
-
-                        /**
-                         * Decodes a TeamListItem message from the specified reader or buffer.
-                         * @param reader Reader or buffer to decode from
-                         * @param [length] Message length if known beforehand
-                         * @returns TeamListItem
-                         * @throws {Error} If the payload is not a reader or valid buffer
-                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
-                         */
-                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
-
-                        /**
-                         * Decodes a TeamListItem message from the specified reader or buffer, length delimited.
-                         * @param reader Reader or buffer to decode from
-                         * @returns TeamListItem
-                         * @throws {Error} If the payload is not a reader or valid buffer
-                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
-                         */
-                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
-
-                        /**
-                         * Verifies a TeamListItem message.
-                         * @param message Plain object to verify
-                         * @returns `null` if valid, otherwise the reason why it is not
-                         */
-                        public static verify(message: { [k: string]: any }): (string|null);
-
-                        /**
-                         * Creates a TeamListItem message from a plain object. Also converts values to their respective internal types.
-                         * @param object Plain object
-                         * @returns TeamListItem
-                         */
-                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
-
-                        /**
-                         * Creates a plain object from a TeamListItem message. Also converts values to other types if specified.
-                         * @param message TeamListItem
-                         * @param [options] Conversion options
-                         * @returns Plain object
-                         */
-                        public static toObject(message: xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem, options?: $protobuf.IConversionOptions): { [k: string]: any };
-
-                        /**
-                         * Converts this TeamListItem to JSON.
-                         * @returns JSON object
-                         */
-                        public toJSON(): { [k: string]: any };
-                    }
-                }
-
-                /** Properties of a GetTeamRequest. */
-                interface IGetTeamRequest {
-
-                    /** GetTeamRequest id */
-                    id?: (number|Long|null);
-                }
-
-                /** Represents a GetTeamRequest. */
-                class GetTeamRequest implements IGetTeamRequest {
+                /** Represents a CreateContestResponse. */
+                class CreateContestResponse implements ICreateContestResponse {
 
                     /**
-                     * Constructs a new GetTeamRequest.
+                     * Constructs a new CreateContestResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IGetTeamRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.ICreateContestResponse);
 
-                    /** GetTeamRequest id. */
-                    public id: (number|Long);
+                    /** CreateContestResponse contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
 
                     /**
-                     * Creates a new GetTeamRequest instance using the specified properties.
+                     * Creates a new CreateContestResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns GetTeamRequest instance
+                     * @returns CreateContestResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IGetTeamRequest): xsuportal.proto.services.admin.GetTeamRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.ICreateContestResponse): xsuportal.proto.services.admin.CreateContestResponse;
 
                     /**
-                     * Encodes the specified GetTeamRequest message. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamRequest.verify|verify} messages.
-                     * @param message GetTeamRequest message or plain object to encode
+                     * Encodes the specified CreateContestResponse message. Does not implicitly {@link xsuportal.proto.services.admin.CreateContestResponse.verify|verify} messages.
+                     * @param message CreateContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IGetTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.ICreateContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified GetTeamRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamRequest.verify|verify} messages.
-                     * @param message GetTeamRequest message or plain object to encode
+                     * Encodes the specified CreateContestResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.CreateContestResponse.verify|verify} messages.
+                     * @param message CreateContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IGetTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.ICreateContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a GetTeamRequest message from the specified reader or buffer.
+                     * Decodes a CreateContestResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns GetTeamRequest
+                     * @returns CreateContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.GetTeamRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.CreateContestResponse;
 
                     /**
-                     * Decodes a GetTeamRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a CreateContestResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns GetTeamRequest
+                     * @returns CreateContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.GetTeamRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.CreateContestResponse;
 
                     /**
-                     * Verifies a GetTeamRequest message.
+                     * Verifies a CreateContestResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a GetTeamRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a CreateContestResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns GetTeamRequest
+                     * @returns CreateContestResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.GetTeamRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.CreateContestResponse;
 
                     /**
-                     * Creates a plain object from a GetTeamRequest message. Also converts values to other types if specified.
-                     * @param message GetTeamRequest
+                     * Creates a plain object from a CreateContestResponse message. Also converts values to other types if specified.
+                     * @param message CreateContestResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.GetTeamRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.CreateContestResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this GetTeamRequest to JSON.
+                     * Converts this CreateContestResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a GetTeamResponse. */
-                interface IGetTeamResponse {
+                /** Properties of an ActivateContestResponse. */
+                interface IActivateContestResponse {
 
-                    /** GetTeamResponse team */
-                    team?: (xsuportal.proto.resources.ITeam|null);
+                    /** ActivateContestResponse contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
                 }
 
-                /** Represents a GetTeamResponse. */
-                class GetTeamResponse implements IGetTeamResponse {
+                /** Represents an ActivateContestResponse. */
+                class ActivateContestResponse implements IActivateContestResponse {
 
                     /**
-                     * Constructs a new GetTeamResponse.
+                     * Constructs a new ActivateContestResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IGetTeamResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IActivateContestResponse);
 
-                    /** GetTeamResponse team. */
-                    public team?: (xsuportal.proto.resources.ITeam|null);
+                    /** ActivateContestResponse contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
 
                     /**
-                     * Creates a new GetTeamResponse instance using the specified properties.
+                     * Creates a new ActivateContestResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns GetTeamResponse instance
+                     * @returns ActivateContestResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IGetTeamResponse): xsuportal.proto.services.admin.GetTeamResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IActivateContestResponse): xsuportal.proto.services.admin.ActivateContestResponse;
 
                     /**
-                     * Encodes the specified GetTeamResponse message. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamResponse.verify|verify} messages.
-                     * @param message GetTeamResponse message or plain object to encode
+                     * Encodes the specified ActivateContestResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ActivateContestResponse.verify|verify} messages.
+                     * @param message ActivateContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IGetTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IActivateContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified GetTeamResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamResponse.verify|verify} messages.
-                     * @param message GetTeamResponse message or plain object to encode
+                     * Encodes the specified ActivateContestResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ActivateContestResponse.verify|verify} messages.
+                     * @param message ActivateContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IGetTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IActivateContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a GetTeamResponse message from the specified reader or buffer.
+                     * Decodes an ActivateContestResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns GetTeamResponse
+                     * @returns ActivateContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.GetTeamResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ActivateContestResponse;
 
                     /**
-                     * Decodes a GetTeamResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an ActivateContestResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns GetTeamResponse
+                     * @returns ActivateContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.GetTeamResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ActivateContestResponse;
 
                     /**
-                     * Verifies a GetTeamResponse message.
+                     * Verifies an ActivateContestResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a GetTeamResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an ActivateContestResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns GetTeamResponse
+                     * @returns ActivateContestResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.GetTeamResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ActivateContestResponse;
 
                     /**
-                     * Creates a plain object from a GetTeamResponse message. Also converts values to other types if specified.
-                     * @param message GetTeamResponse
+                     * Creates a plain object from an ActivateContestResponse message. Also converts values to other types if specified.
+                     * @param message ActivateContestResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.GetTeamResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.ActivateContestResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this GetTeamResponse to JSON.
+                     * Converts this ActivateContestResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an UpdateTeamRequest. */
-                interface IUpdateTeamRequest {
-
-                    /** UpdateTeamRequest team */
-                    team?: (xsuportal.proto.resources.ITeam|null);
+                /** Properties of an ArchiveContestResponse. */
+                interface IArchiveContestResponse {
 
-                    /** UpdateTeamRequest contestants */
-                    contestants?: (xsuportal.proto.resources.IContestant[]|null);
+                    /** ArchiveContestResponse contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
                 }
 
-                /** Represents an UpdateTeamRequest. */
-                class UpdateTeamRequest implements IUpdateTeamRequest {
+                /** Represents an ArchiveContestResponse. */
+                class ArchiveContestResponse implements IArchiveContestResponse {
 
                     /**
-                     * Constructs a new UpdateTeamRequest.
+                     * Constructs a new ArchiveContestResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IUpdateTeamRequest);
-
-                    /** UpdateTeamRequest team. */
-                    public team?: (xsuportal.proto.resources.ITeam|null);
+                    constructor(properties?: xsuportal.proto.services.admin.IArchiveContestResponse);
 
-                    /** UpdateTeamRequest contestants. */
-                    public contestants: xsuportal.proto.resources.IContestant[];
+                    /** ArchiveContestResponse contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
 
                     /**
-                     * Creates a new UpdateTeamRequest instance using the specified properties.
+                     * Creates a new ArchiveContestResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns UpdateTeamRequest instance
+                     * @returns ArchiveContestResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IUpdateTeamRequest): xsuportal.proto.services.admin.UpdateTeamRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IArchiveContestResponse): xsuportal.proto.services.admin.ArchiveContestResponse;
 
                     /**
-                     * Encodes the specified UpdateTeamRequest message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamRequest.verify|verify} messages.
-                     * @param message UpdateTeamRequest message or plain object to encode
+                     * Encodes the specified ArchiveContestResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ArchiveContestResponse.verify|verify} messages.
+                     * @param message ArchiveContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IUpdateTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IArchiveContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified UpdateTeamRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamRequest.verify|verify} messages.
-                     * @param message UpdateTeamRequest message or plain object to encode
+                     * Encodes the specified ArchiveContestResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ArchiveContestResponse.verify|verify} messages.
+                     * @param message ArchiveContestResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IArchiveContestResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an UpdateTeamRequest message from the specified reader or buffer.
+                     * Decodes an ArchiveContestResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns UpdateTeamRequest
+                     * @returns ArchiveContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateTeamRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ArchiveContestResponse;
 
                     /**
-                     * Decodes an UpdateTeamRequest message from the specified reader or buffer, length delimited.
+                     * Decodes an ArchiveContestResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns UpdateTeamRequest
+                     * @returns ArchiveContestResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateTeamRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ArchiveContestResponse;
 
                     /**
-                     * Verifies an UpdateTeamRequest message.
+                     * Verifies an ArchiveContestResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an UpdateTeamRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates an ArchiveContestResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns UpdateTeamRequest
+                     * @returns ArchiveContestResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateTeamRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ArchiveContestResponse;
 
                     /**
-                     * Creates a plain object from an UpdateTeamRequest message. Also converts values to other types if specified.
-                     * @param message UpdateTeamRequest
+                     * Creates a plain object from an ArchiveContestResponse message. Also converts values to other types if specified.
+                     * @param message ArchiveContestResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.UpdateTeamRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.ArchiveContestResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this UpdateTeamRequest to JSON.
+                     * Converts this ArchiveContestResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an UpdateTeamResponse. */
-                interface IUpdateTeamResponse {
+                /** Properties of a DashboardRequest. */
+                interface IDashboardRequest {
                 }
 
-                /** Represents an UpdateTeamResponse. */
-                class UpdateTeamResponse implements IUpdateTeamResponse {
+                /** Represents a DashboardRequest. */
+                class DashboardRequest implements IDashboardRequest {
 
                     /**
-                     * Constructs a new UpdateTeamResponse.
+                     * Constructs a new DashboardRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.admin.IUpdateTeamResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IDashboardRequest);
 
                     /**
-                     * Creates a new UpdateTeamResponse instance using the specified properties.
+                     * Creates a new DashboardRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns UpdateTeamResponse instance
+                     * @returns DashboardRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.admin.IUpdateTeamResponse): xsuportal.proto.services.admin.UpdateTeamResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IDashboardRequest): xsuportal.proto.services.admin.DashboardRequest;
 
                     /**
-                     * Encodes the specified UpdateTeamResponse message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamResponse.verify|verify} messages.
-                     * @param message UpdateTeamResponse message or plain object to encode
+                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.admin.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.admin.IUpdateTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified UpdateTeamResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamResponse.verify|verify} messages.
-                     * @param message UpdateTeamResponse message or plain object to encode
+                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an UpdateTeamResponse message from the specified reader or buffer.
+                     * Decodes a DashboardRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns UpdateTeamResponse
+                     * @returns DashboardRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateTeamResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.DashboardRequest;
 
                     /**
-                     * Decodes an UpdateTeamResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns UpdateTeamResponse
+                     * @returns DashboardRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateTeamResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.DashboardRequest;
 
                     /**
-                     * Verifies an UpdateTeamResponse message.
+                     * Verifies a DashboardRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an UpdateTeamResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns UpdateTeamResponse
+                     * @returns DashboardRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateTeamResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.DashboardRequest;
 
                     /**
-                     * Creates a plain object from an UpdateTeamResponse message. Also converts values to other types if specified.
-                     * @param message UpdateTeamResponse
+                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
+                     * @param message DashboardRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.admin.UpdateTeamResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this UpdateTeamResponse to JSON.
+                     * Converts this DashboardRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
-            }
 
-            /** Namespace audience. */
-            namespace audience {
+                /** Properties of a DashboardResponse. */
+                interface IDashboardResponse {
 
-                /** Properties of a DashboardRequest. */
-                interface IDashboardRequest {
+                    /** DashboardResponse leaderboard */
+                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
                 }
 
-                /** Represents a DashboardRequest. */
-                class DashboardRequest implements IDashboardRequest {
+                /** Represents a DashboardResponse. */
+                class DashboardResponse implements IDashboardResponse {
 
                     /**
-                     * Constructs a new DashboardRequest.
+                     * Constructs a new DashboardResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.audience.IDashboardRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.IDashboardResponse);
+
+                    /** DashboardResponse leaderboard. */
+                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
 
                     /**
-                     * Creates a new DashboardRequest instance using the specified properties.
+                     * Creates a new DashboardResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardRequest instance
+                     * @returns DashboardResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.audience.IDashboardRequest): xsuportal.proto.services.audience.DashboardRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IDashboardResponse): xsuportal.proto.services.admin.DashboardResponse;
 
                     /**
-                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.audience.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.admin.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.audience.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.audience.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer.
+                     * Decodes a DashboardResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardRequest
+                     * @returns DashboardResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.DashboardRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.DashboardResponse;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardRequest
+                     * @returns DashboardResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.DashboardRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.DashboardResponse;
 
                     /**
-                     * Verifies a DashboardRequest message.
+                     * Verifies a DashboardResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardRequest
+                     * @returns DashboardResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.DashboardRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.DashboardResponse;
 
                     /**
-                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
-                     * @param message DashboardRequest
+                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
+                     * @param message DashboardResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.audience.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardRequest to JSON.
+                     * Converts this DashboardResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a DashboardResponse. */
-                interface IDashboardResponse {
+                /** Properties of an InitializeRequest. */
+                interface IInitializeRequest {
 
-                    /** DashboardResponse leaderboard */
-                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** InitializeRequest contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
                 }
 
-                /** Represents a DashboardResponse. */
-                class DashboardResponse implements IDashboardResponse {
+                /** Represents an InitializeRequest. */
+                class InitializeRequest implements IInitializeRequest {
 
                     /**
-                     * Constructs a new DashboardResponse.
+                     * Constructs a new InitializeRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.audience.IDashboardResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IInitializeRequest);
 
-                    /** DashboardResponse leaderboard. */
-                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** InitializeRequest contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
 
                     /**
-                     * Creates a new DashboardResponse instance using the specified properties.
+                     * Creates a new InitializeRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardResponse instance
+                     * @returns InitializeRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.audience.IDashboardResponse): xsuportal.proto.services.audience.DashboardResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IInitializeRequest): xsuportal.proto.services.admin.InitializeRequest;
 
                     /**
-                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.audience.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified InitializeRequest message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeRequest.verify|verify} messages.
+                     * @param message InitializeRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.audience.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IInitializeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified InitializeRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeRequest.verify|verify} messages.
+                     * @param message InitializeRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.audience.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IInitializeRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer.
+                     * Decodes an InitializeRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardResponse
+                     * @returns InitializeRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.DashboardResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeRequest;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an InitializeRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardResponse
+                     * @returns InitializeRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.DashboardResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeRequest;
 
                     /**
-                     * Verifies a DashboardResponse message.
+                     * Verifies an InitializeRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an InitializeRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardResponse
+                     * @returns InitializeRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.DashboardResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeRequest;
 
                     /**
-                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
-                     * @param message DashboardResponse
+                     * Creates a plain object from an InitializeRequest message. Also converts values to other types if specified.
+                     * @param message InitializeRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.audience.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.InitializeRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardResponse to JSON.
+                     * Converts this InitializeRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListTeamsResponse. */
-                interface IListTeamsResponse {
+                /** Properties of an InitializeResponse. */
+                interface IInitializeResponse {
 
-                    /** ListTeamsResponse teams */
-                    teams?: (xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem[]|null);
+                    /** InitializeResponse language */
+                    language?: (string|null);
+
+                    /** InitializeResponse benchmarkServer */
+                    benchmarkServer?: (xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer|null);
+
+                    /** InitializeResponse adminPassword */
+                    adminPassword?: (string|null);
                 }
 
-                /** Represents a ListTeamsResponse. */
-                class ListTeamsResponse implements IListTeamsResponse {
+                /** Represents an InitializeResponse. */
+                class InitializeResponse implements IInitializeResponse {
 
                     /**
-                     * Constructs a new ListTeamsResponse.
+                     * Constructs a new InitializeResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.audience.IListTeamsResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IInitializeResponse);
 
-                    /** ListTeamsResponse teams. */
-                    public teams: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem[];
+                    /** InitializeResponse language. */
+                    public language: string;
+
+                    /** InitializeResponse benchmarkServer. */
+                    public benchmarkServer?: (xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer|null);
+
+                    /** InitializeResponse adminPassword. */
+                    public adminPassword: string;
 
                     /**
-                     * Creates a new ListTeamsResponse instance using the specified properties.
+                     * Creates a new InitializeResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListTeamsResponse instance
+                     * @returns InitializeResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.audience.IListTeamsResponse): xsuportal.proto.services.audience.ListTeamsResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IInitializeResponse): xsuportal.proto.services.admin.InitializeResponse;
 
                     /**
-                     * Encodes the specified ListTeamsResponse message. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.verify|verify} messages.
-                     * @param message ListTeamsResponse message or plain object to encode
+                     * Encodes the specified InitializeResponse message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.verify|verify} messages.
+                     * @param message InitializeResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.audience.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IInitializeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListTeamsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.verify|verify} messages.
-                     * @param message ListTeamsResponse message or plain object to encode
+                     * Encodes the specified InitializeResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.verify|verify} messages.
+                     * @param message InitializeResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.audience.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IInitializeResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListTeamsResponse message from the specified reader or buffer.
+                     * Decodes an InitializeResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListTeamsResponse
+                     * @returns InitializeResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.ListTeamsResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeResponse;
 
                     /**
-                     * Decodes a ListTeamsResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an InitializeResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListTeamsResponse
+                     * @returns InitializeResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.ListTeamsResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeResponse;
 
                     /**
-                     * Verifies a ListTeamsResponse message.
+                     * Verifies an InitializeResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListTeamsResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an InitializeResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListTeamsResponse
+                     * @returns InitializeResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.ListTeamsResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeResponse;
 
                     /**
-                     * Creates a plain object from a ListTeamsResponse message. Also converts values to other types if specified.
-                     * @param message ListTeamsResponse
+                     * Creates a plain object from an InitializeResponse message. Also converts values to other types if specified.
+                     * @param message InitializeResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.audience.ListTeamsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.InitializeResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListTeamsResponse to JSON.
+                     * Converts this InitializeResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                namespace ListTeamsResponse {
-
-                    /** Properties of a TeamListItem. */
-                    interface ITeamListItem {
-
-                        /** TeamListItem teamId */
-                        teamId?: (number|Long|null);
+                namespace InitializeResponse {
 
-                        /** TeamListItem name */
-                        name?: (string|null);
+                    /** Properties of a BenchmarkServer. */
+                    interface IBenchmarkServer {
 
-                        /** TeamListItem memberNames */
-                        memberNames?: (string[]|null);
+                        /** BenchmarkServer host */
+                        host?: (string|null);
 
-                        /** TeamListItem isStudent */
-                        isStudent?: (boolean|null);
+                        /** BenchmarkServer port */
+                        port?: (number|Long|null);
                     }
 
-                    /** Represents a TeamListItem. */
-                    class TeamListItem implements ITeamListItem {
+                    /** Represents a BenchmarkServer. */
+                    class BenchmarkServer implements IBenchmarkServer {
 
                         /**
-                         * Constructs a new TeamListItem.
+                         * Constructs a new BenchmarkServer.
                          * @param [properties] Properties to set
                          */
-                        constructor(properties?: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem);
-
-                        /** TeamListItem teamId. */
-                        public teamId: (number|Long);
-
-                        /** TeamListItem name. */
-                        public name: string;
+                        constructor(properties?: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer);
 
-                        /** TeamListItem memberNames. */
-                        public memberNames: string[];
+                        /** BenchmarkServer host. */
+                        public host: string;
 
-                        /** TeamListItem isStudent. */
-                        public isStudent: boolean;
+                        /** BenchmarkServer port. */
+                        public port: (number|Long);
 
                         /**
-                         * Creates a new TeamListItem instance using the specified properties.
+                         * Creates a new BenchmarkServer instance using the specified properties.
                          * @param [properties] Properties to set
-                         * @returns TeamListItem instance
+                         * @returns BenchmarkServer instance
                          */
-                        public static create(properties?: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+                        public static create(properties?: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
 
                         /**
-                         * Encodes the specified TeamListItem message. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem.verify|verify} messages.
-                         * @param message TeamListItem message or plain object to encode
+                         * Encodes the specified BenchmarkServer message. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer.verify|verify} messages.
+                         * @param message BenchmarkServer message or plain object to encode
                          * @param [writer] Writer to encode to
                          * @returns Writer
                          */
-                        public static encode(message: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+                        public static encode(message: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer, writer?: $protobuf.Writer): $protobuf.Writer;
 
                         /**
-                         * Encodes the specified TeamListItem message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem.verify|verify} messages.
-                         * @param message TeamListItem message or plain object to encode
+                         * Encodes the specified BenchmarkServer message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer.verify|verify} messages.
+                         * @param message BenchmarkServer message or plain object to encode
                          * @param [writer] Writer to encode to
                          * @returns Writer
                          */
-                        public static encodeDelimited(message: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+                        public static encodeDelimited(message: xsuportal.proto.services.admin.InitializeResponse.IBenchmarkServer, writer?: $protobuf.Writer): $protobuf.Writer;
 
                         /**
-                         * Decodes a TeamListItem message from the specified reader or buffer.
+                         * Decodes a BenchmarkServer message from the specified reader or buffer.
                          * @param reader Reader or buffer to decode from
                          * @param [length] Message length if known beforehand
-                         * @returns TeamListItem
+                         * @returns BenchmarkServer
                          * @throws {Error} If the payload is not a reader or valid buffer
                          * @throws {$protobuf.util.ProtocolError} If required fields are missing
                          */
-                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
 
                         /**
-                         * Decodes a TeamListItem message from the specified reader or buffer, length delimited.
+                         * Decodes a BenchmarkServer message from the specified reader or buffer, length delimited.
                          * @param reader Reader or buffer to decode from
-                         * @returns TeamListItem
+                         * @returns BenchmarkServer
                          * @throws {Error} If the payload is not a reader or valid buffer
                          * @throws {$protobuf.util.ProtocolError} If required fields are missing
                          */
-                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
 
                         /**
-                         * Verifies a TeamListItem message.
+                         * Verifies a BenchmarkServer message.
                          * @param message Plain object to verify
                          * @returns `null` if valid, otherwise the reason why it is not
                          */
                         public static verify(message: { [k: string]: any }): (string|null);
 
                         /**
-                         * Creates a TeamListItem message from a plain object. Also converts values to their respective internal types.
+                         * Creates a BenchmarkServer message from a plain object. Also converts values to their respective internal types.
                          * @param object Plain object
-                         * @returns TeamListItem
+                         * @returns BenchmarkServer
                          */
-                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer;
 
                         /**
-                         * Creates a plain object from a TeamListItem message. Also converts values to other types if specified.
-                         * @param message TeamListItem
+                         * Creates a plain object from a BenchmarkServer message. Also converts values to other types if specified.
+                         * @param message BenchmarkServer
                          * @param [options] Conversion options
                          * @returns Plain object
                          */
-                        public static toObject(message: xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                        public static toObject(message: xsuportal.proto.services.admin.InitializeResponse.BenchmarkServer, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                         /**
-                         * Converts this TeamListItem to JSON.
+                         * Converts this BenchmarkServer to JSON.
                          * @returns JSON object
                          */
                         public toJSON(): { [k: string]: any };
                     }
                 }
-            }
-
-            /** Namespace bench. */
-            namespace bench {
-
-                /** Represents a BenchmarkQueue */
-                class BenchmarkQueue extends $protobuf.rpc.Service {
-
-                    /**
-                     * Constructs a new BenchmarkQueue service.
-                     * @param rpcImpl RPC implementation
-                     * @param [requestDelimited=false] Whether requests are length-delimited
-                     * @param [responseDelimited=false] Whether responses are length-delimited
-                     */
-                    constructor(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean);
-
-                    /**
-                     * Creates new BenchmarkQueue service using the specified rpc implementation.
-                     * @param rpcImpl RPC implementation
-                     * @param [requestDelimited=false] Whether requests are length-delimited
-                     * @param [responseDelimited=false] Whether responses are length-delimited
-                     * @returns RPC service. Useful where requests and/or responses are streamed.
-                     */
-                    public static create(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean): BenchmarkQueue;
-
-                    /**
-                     * Calls ReceiveBenchmarkJob.
-                     * @param request ReceiveBenchmarkJobRequest message or plain object
-                     * @param callback Node-style callback called with the error, if any, and ReceiveBenchmarkJobResponse
-                     */
-                    public receiveBenchmarkJob(request: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, callback: xsuportal.proto.services.bench.BenchmarkQueue.ReceiveBenchmarkJobCallback): void;
-
-                    /**
-                     * Calls ReceiveBenchmarkJob.
-                     * @param request ReceiveBenchmarkJobRequest message or plain object
-                     * @returns Promise
-                     */
-                    public receiveBenchmarkJob(request: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest): Promise<xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse>;
-                }
-
-                namespace BenchmarkQueue {
-
-                    /**
-                     * Callback as used by {@link xsuportal.proto.services.bench.BenchmarkQueue#receiveBenchmarkJob}.
-                     * @param error Error, if any
-                     * @param [response] ReceiveBenchmarkJobResponse
-                     */
-                    type ReceiveBenchmarkJobCallback = (error: (Error|null), response?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse) => void;
-                }
 
-                /** Properties of a ReceiveBenchmarkJobRequest. */
-                interface IReceiveBenchmarkJobRequest {
+                /** Properties of a ListLoginAttemptsResponse. */
+                interface IListLoginAttemptsResponse {
 
-                    /** ReceiveBenchmarkJobRequest teamId */
-                    teamId?: (number|Long|null);
+                    /** ListLoginAttemptsResponse loginAttempts */
+                    loginAttempts?: (xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt[]|null);
                 }
 
-                /** Represents a ReceiveBenchmarkJobRequest. */
-                class ReceiveBenchmarkJobRequest implements IReceiveBenchmarkJobRequest {
+                /** Represents a ListLoginAttemptsResponse. */
+                class ListLoginAttemptsResponse implements IListLoginAttemptsResponse {
 
                     /**
-                     * Constructs a new ReceiveBenchmarkJobRequest.
+                     * Constructs a new ListLoginAttemptsResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest);
+                    constructor(properties?: xsuportal.proto.services.admin.IListLoginAttemptsResponse);
 
-                    /** ReceiveBenchmarkJobRequest teamId. */
-                    public teamId: (number|Long);
+                    /** ListLoginAttemptsResponse loginAttempts. */
+                    public loginAttempts: xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt[];
 
                     /**
-                     * Creates a new ReceiveBenchmarkJobRequest instance using the specified properties.
+                     * Creates a new ListLoginAttemptsResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ReceiveBenchmarkJobRequest instance
+                     * @returns ListLoginAttemptsResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IListLoginAttemptsResponse): xsuportal.proto.services.admin.ListLoginAttemptsResponse;
 
                     /**
-                     * Encodes the specified ReceiveBenchmarkJobRequest message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest.verify|verify} messages.
-                     * @param message ReceiveBenchmarkJobRequest message or plain object to encode
+                     * Encodes the specified ListLoginAttemptsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListLoginAttemptsResponse.verify|verify} messages.
+                     * @param message ListLoginAttemptsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IListLoginAttemptsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ReceiveBenchmarkJobRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest.verify|verify} messages.
-                     * @param message ReceiveBenchmarkJobRequest message or plain object to encode
+                     * Encodes the specified ListLoginAttemptsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListLoginAttemptsResponse.verify|verify} messages.
+                     * @param message ListLoginAttemptsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListLoginAttemptsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ReceiveBenchmarkJobRequest message from the specified reader or buffer.
+                     * Decodes a ListLoginAttemptsResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ReceiveBenchmarkJobRequest
+                     * @returns ListLoginAttemptsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListLoginAttemptsResponse;
 
                     /**
-                     * Decodes a ReceiveBenchmarkJobRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a ListLoginAttemptsResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ReceiveBenchmarkJobRequest
+                     * @returns ListLoginAttemptsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListLoginAttemptsResponse;
 
                     /**
-                     * Verifies a ReceiveBenchmarkJobRequest message.
+                     * Verifies a ListLoginAttemptsResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ReceiveBenchmarkJobRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListLoginAttemptsResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ReceiveBenchmarkJobRequest
+                     * @returns ListLoginAttemptsResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListLoginAttemptsResponse;
 
                     /**
-                     * Creates a plain object from a ReceiveBenchmarkJobRequest message. Also converts values to other types if specified.
-                     * @param message ReceiveBenchmarkJobRequest
+                     * Creates a plain object from a ListLoginAttemptsResponse message. Also converts values to other types if specified.
+                     * @param message ListLoginAttemptsResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.ListLoginAttemptsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ReceiveBenchmarkJobRequest to JSON.
+                     * Converts this ListLoginAttemptsResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ReceiveBenchmarkJobResponse. */
-                interface IReceiveBenchmarkJobResponse {
+                namespace ListLoginAttemptsResponse {
 
-                    /** ReceiveBenchmarkJobResponse jobHandle */
-                    jobHandle?: (xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle|null);
+                    /** Properties of a LoginAttempt. */
+                    interface ILoginAttempt {
+
+                        /** LoginAttempt scope */
+                        scope?: (string|null);
+
+                        /** LoginAttempt key */
+                        key?: (string|null);
+
+                        /** LoginAttempt failures */
+                        failures?: (number|Long|null);
+
+                        /** LoginAttempt lockedUntil */
+                        lockedUntil?: (google.protobuf.ITimestamp|null);
+
+                        /** LoginAttempt lastFailedAt */
+                        lastFailedAt?: (google.protobuf.ITimestamp|null);
+                    }
+
+                    /** Represents a LoginAttempt. */
+                    class LoginAttempt implements ILoginAttempt {
+
+                        /**
+                         * Constructs a new LoginAttempt.
+                         * @param [properties] Properties to set
+                         */
+                        constructor(properties?: xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt);
+
+                        /** LoginAttempt scope. */
+                        public scope: string;
+
+                        /** LoginAttempt key. */
+                        public key: string;
+
+                        /** LoginAttempt failures. */
+                        public failures: (number|Long);
+
+                        /** LoginAttempt lockedUntil. */
+                        public lockedUntil?: (google.protobuf.ITimestamp|null);
+
+                        /** LoginAttempt lastFailedAt. */
+                        public lastFailedAt?: (google.protobuf.ITimestamp|null);
+
+                        /**
+                         * Creates a new LoginAttempt instance using the specified properties.
+                         * @param [properties] Properties to set
+                         * @returns LoginAttempt instance
+                         */
+                        public static create(properties?: xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt): xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt;
+
+                        /**
+                         * Encodes the specified LoginAttempt message. Does not implicitly {@link xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt.verify|verify} messages.
+                         * @param message LoginAttempt message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encode(message: xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Encodes the specified LoginAttempt message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt.verify|verify} messages.
+                         * @param message LoginAttempt message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encodeDelimited(message: xsuportal.proto.services.admin.ListLoginAttemptsResponse.ILoginAttempt, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Decodes a LoginAttempt message from the specified reader or buffer.
+                         * @param reader Reader or buffer to decode from
+                         * @param [length] Message length if known beforehand
+                         * @returns LoginAttempt
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt;
+
+                        /**
+                         * Decodes a LoginAttempt message from the specified reader or buffer, length delimited.
+                         * @param reader Reader or buffer to decode from
+                         * @returns LoginAttempt
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt;
+
+                        /**
+                         * Verifies a LoginAttempt message.
+                         * @param message Plain object to verify
+                         * @returns `null` if valid, otherwise the reason why it is not
+                         */
+                        public static verify(message: { [k: string]: any }): (string|null);
+
+                        /**
+                         * Creates a LoginAttempt message from a plain object. Also converts values to their respective internal types.
+                         * @param object Plain object
+                         * @returns LoginAttempt
+                         */
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt;
+
+                        /**
+                         * Creates a plain object from a LoginAttempt message. Also converts values to other types if specified.
+                         * @param message LoginAttempt
+                         * @param [options] Conversion options
+                         * @returns Plain object
+                         */
+                        public static toObject(message: xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                        /**
+                         * Converts this LoginAttempt to JSON.
+                         * @returns JSON object
+                         */
+                        public toJSON(): { [k: string]: any };
+                    }
                 }
 
-                /** Represents a ReceiveBenchmarkJobResponse. */
-                class ReceiveBenchmarkJobResponse implements IReceiveBenchmarkJobResponse {
+                /** Properties of a ListContestantSessionsResponse. */
+                interface IListContestantSessionsResponse {
+
+                    /** ListContestantSessionsResponse sessions */
+                    sessions?: (xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession[]|null);
+                }
+
+                /** Represents a ListContestantSessionsResponse. */
+                class ListContestantSessionsResponse implements IListContestantSessionsResponse {
 
                     /**
-                     * Constructs a new ReceiveBenchmarkJobResponse.
+                     * Constructs a new ListContestantSessionsResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IListContestantSessionsResponse);
 
-                    /** ReceiveBenchmarkJobResponse jobHandle. */
-                    public jobHandle?: (xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle|null);
+                    /** ListContestantSessionsResponse sessions. */
+                    public sessions: xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession[];
 
                     /**
-                     * Creates a new ReceiveBenchmarkJobResponse instance using the specified properties.
+                     * Creates a new ListContestantSessionsResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ReceiveBenchmarkJobResponse instance
+                     * @returns ListContestantSessionsResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IListContestantSessionsResponse): xsuportal.proto.services.admin.ListContestantSessionsResponse;
 
                     /**
-                     * Encodes the specified ReceiveBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.verify|verify} messages.
-                     * @param message ReceiveBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified ListContestantSessionsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListContestantSessionsResponse.verify|verify} messages.
+                     * @param message ListContestantSessionsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IListContestantSessionsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ReceiveBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.verify|verify} messages.
-                     * @param message ReceiveBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified ListContestantSessionsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListContestantSessionsResponse.verify|verify} messages.
+                     * @param message ListContestantSessionsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListContestantSessionsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ReceiveBenchmarkJobResponse message from the specified reader or buffer.
+                     * Decodes a ListContestantSessionsResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ReceiveBenchmarkJobResponse
+                     * @returns ListContestantSessionsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListContestantSessionsResponse;
 
                     /**
-                     * Decodes a ReceiveBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a ListContestantSessionsResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ReceiveBenchmarkJobResponse
+                     * @returns ListContestantSessionsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListContestantSessionsResponse;
 
                     /**
-                     * Verifies a ReceiveBenchmarkJobResponse message.
+                     * Verifies a ListContestantSessionsResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ReceiveBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListContestantSessionsResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ReceiveBenchmarkJobResponse
+                     * @returns ListContestantSessionsResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListContestantSessionsResponse;
 
                     /**
-                     * Creates a plain object from a ReceiveBenchmarkJobResponse message. Also converts values to other types if specified.
-                     * @param message ReceiveBenchmarkJobResponse
+                     * Creates a plain object from a ListContestantSessionsResponse message. Also converts values to other types if specified.
+                     * @param message ListContestantSessionsResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.ListContestantSessionsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ReceiveBenchmarkJobResponse to JSON.
+                     * Converts this ListContestantSessionsResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                namespace ReceiveBenchmarkJobResponse {
+                namespace ListContestantSessionsResponse {
 
-                    /** Properties of a JobHandle. */
-                    interface IJobHandle {
+                    /** Properties of a Session. */
+                    interface ISession {
 
-                        /** JobHandle jobId */
-                        jobId?: (number|Long|null);
+                        /** Session id */
+                        id?: (string|null);
 
-                        /** JobHandle handle */
-                        handle?: (string|null);
+                        /** Session createdAt */
+                        createdAt?: (google.protobuf.ITimestamp|null);
 
-                        /** JobHandle targetHostname */
-                        targetHostname?: (string|null);
+                        /** Session updatedAt */
+                        updatedAt?: (google.protobuf.ITimestamp|null);
 
-                        /** JobHandle contestStartedAt */
-                        contestStartedAt?: (google.protobuf.ITimestamp|null);
+                        /** Session expiresAt */
+                        expiresAt?: (google.protobuf.ITimestamp|null);
+                    }
 
-                        /** JobHandle jobCreatedAt */
-                        jobCreatedAt?: (google.protobuf.ITimestamp|null);
-                    }
-
-                    /** Represents a JobHandle. */
-                    class JobHandle implements IJobHandle {
+                    /** Represents a Session. */
+                    class Session implements ISession {
 
                         /**
-                         * Constructs a new JobHandle.
+                         * Constructs a new Session.
                          * @param [properties] Properties to set
                          */
-                        constructor(properties?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle);
+                        constructor(properties?: xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession);
 
-                        /** JobHandle jobId. */
-                        public jobId: (number|Long);
-
-                        /** JobHandle handle. */
-                        public handle: string;
+                        /** Session id. */
+                        public id: string;
 
-                        /** JobHandle targetHostname. */
-                        public targetHostname: string;
+                        /** Session createdAt. */
+                        public createdAt?: (google.protobuf.ITimestamp|null);
 
-                        /** JobHandle contestStartedAt. */
-                        public contestStartedAt?: (google.protobuf.ITimestamp|null);
+                        /** Session updatedAt. */
+                        public updatedAt?: (google.protobuf.ITimestamp|null);
 
-                        /** JobHandle jobCreatedAt. */
-                        public jobCreatedAt?: (google.protobuf.ITimestamp|null);
+                        /** Session expiresAt. */
+                        public expiresAt?: (google.protobuf.ITimestamp|null);
 
                         /**
-                         * Creates a new JobHandle instance using the specified properties.
+                         * Creates a new Session instance using the specified properties.
                          * @param [properties] Properties to set
-                         * @returns JobHandle instance
+                         * @returns Session instance
                          */
-                        public static create(properties?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+                        public static create(properties?: xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession): xsuportal.proto.services.admin.ListContestantSessionsResponse.Session;
 
                         /**
-                         * Encodes the specified JobHandle message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle.verify|verify} messages.
-                         * @param message JobHandle message or plain object to encode
+                         * Encodes the specified Session message. Does not implicitly {@link xsuportal.proto.services.admin.ListContestantSessionsResponse.Session.verify|verify} messages.
+                         * @param message Session message or plain object to encode
                          * @param [writer] Writer to encode to
                          * @returns Writer
                          */
-                        public static encode(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle, writer?: $protobuf.Writer): $protobuf.Writer;
+                        public static encode(message: xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession, writer?: $protobuf.Writer): $protobuf.Writer;
 
                         /**
-                         * Encodes the specified JobHandle message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle.verify|verify} messages.
-                         * @param message JobHandle message or plain object to encode
+                         * Encodes the specified Session message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListContestantSessionsResponse.Session.verify|verify} messages.
+                         * @param message Session message or plain object to encode
                          * @param [writer] Writer to encode to
                          * @returns Writer
                          */
-                        public static encodeDelimited(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle, writer?: $protobuf.Writer): $protobuf.Writer;
+                        public static encodeDelimited(message: xsuportal.proto.services.admin.ListContestantSessionsResponse.ISession, writer?: $protobuf.Writer): $protobuf.Writer;
 
                         /**
-                         * Decodes a JobHandle message from the specified reader or buffer.
+                         * Decodes a Session message from the specified reader or buffer.
                          * @param reader Reader or buffer to decode from
                          * @param [length] Message length if known beforehand
-                         * @returns JobHandle
+                         * @returns Session
                          * @throws {Error} If the payload is not a reader or valid buffer
                          * @throws {$protobuf.util.ProtocolError} If required fields are missing
                          */
-                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListContestantSessionsResponse.Session;
 
                         /**
-                         * Decodes a JobHandle message from the specified reader or buffer, length delimited.
+                         * Decodes a Session message from the specified reader or buffer, length delimited.
                          * @param reader Reader or buffer to decode from
-                         * @returns JobHandle
+                         * @returns Session
                          * @throws {Error} If the payload is not a reader or valid buffer
                          * @throws {$protobuf.util.ProtocolError} If required fields are missing
                          */
-                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListContestantSessionsResponse.Session;
 
                         /**
-                         * Verifies a JobHandle message.
+                         * Verifies a Session message.
                          * @param message Plain object to verify
                          * @returns `null` if valid, otherwise the reason why it is not
                          */
                         public static verify(message: { [k: string]: any }): (string|null);
 
                         /**
-                         * Creates a JobHandle message from a plain object. Also converts values to their respective internal types.
+                         * Creates a Session message from a plain object. Also converts values to their respective internal types.
                          * @param object Plain object
-                         * @returns JobHandle
+                         * @returns Session
                          */
-                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListContestantSessionsResponse.Session;
 
                         /**
-                         * Creates a plain object from a JobHandle message. Also converts values to other types if specified.
-                         * @param message JobHandle
+                         * Creates a plain object from a Session message. Also converts values to other types if specified.
+                         * @param message Session
                          * @param [options] Conversion options
                          * @returns Plain object
                          */
-                        public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                        public static toObject(message: xsuportal.proto.services.admin.ListContestantSessionsResponse.Session, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                         /**
-                         * Converts this JobHandle to JSON.
+                         * Converts this Session to JSON.
                          * @returns JSON object
                          */
                         public toJSON(): { [k: string]: any };
                     }
                 }
 
-                /** Represents a BenchmarkReport */
-                class BenchmarkReport extends $protobuf.rpc.Service {
+                /** Properties of a ListStaffsResponse. */
+                interface IListStaffsResponse {
+
+                    /** ListStaffsResponse staffs */
+                    staffs?: (xsuportal.proto.resources.IStaff[]|null);
+                }
+
+                /** Represents a ListStaffsResponse. */
+                class ListStaffsResponse implements IListStaffsResponse {
 
                     /**
-                     * Constructs a new BenchmarkReport service.
-                     * @param rpcImpl RPC implementation
-                     * @param [requestDelimited=false] Whether requests are length-delimited
-                     * @param [responseDelimited=false] Whether responses are length-delimited
+                     * Constructs a new ListStaffsResponse.
+                     * @param [properties] Properties to set
                      */
-                    constructor(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean);
+                    constructor(properties?: xsuportal.proto.services.admin.IListStaffsResponse);
+
+                    /** ListStaffsResponse staffs. */
+                    public staffs: xsuportal.proto.resources.IStaff[];
 
                     /**
-                     * Creates new BenchmarkReport service using the specified rpc implementation.
-                     * @param rpcImpl RPC implementation
-                     * @param [requestDelimited=false] Whether requests are length-delimited
-                     * @param [responseDelimited=false] Whether responses are length-delimited
-                     * @returns RPC service. Useful where requests and/or responses are streamed.
+                     * Creates a new ListStaffsResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListStaffsResponse instance
                      */
-                    public static create(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean): BenchmarkReport;
+                    public static create(properties?: xsuportal.proto.services.admin.IListStaffsResponse): xsuportal.proto.services.admin.ListStaffsResponse;
 
                     /**
-                     * Calls ReportBenchmarkResult.
-                     * @param request ReportBenchmarkResultRequest message or plain object
-                     * @param callback Node-style callback called with the error, if any, and ReportBenchmarkResultResponse
+                     * Encodes the specified ListStaffsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListStaffsResponse.verify|verify} messages.
+                     * @param message ListStaffsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
                      */
-                    public reportBenchmarkResult(request: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, callback: xsuportal.proto.services.bench.BenchmarkReport.ReportBenchmarkResultCallback): void;
+                    public static encode(message: xsuportal.proto.services.admin.IListStaffsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Calls ReportBenchmarkResult.
-                     * @param request ReportBenchmarkResultRequest message or plain object
-                     * @returns Promise
+                     * Encodes the specified ListStaffsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListStaffsResponse.verify|verify} messages.
+                     * @param message ListStaffsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
                      */
-                    public reportBenchmarkResult(request: xsuportal.proto.services.bench.IReportBenchmarkResultRequest): Promise<xsuportal.proto.services.bench.ReportBenchmarkResultResponse>;
-                }
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListStaffsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
-                namespace BenchmarkReport {
+                    /**
+                     * Decodes a ListStaffsResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListStaffsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListStaffsResponse;
 
                     /**
-                     * Callback as used by {@link xsuportal.proto.services.bench.BenchmarkReport#reportBenchmarkResult}.
-                     * @param error Error, if any
-                     * @param [response] ReportBenchmarkResultResponse
+                     * Decodes a ListStaffsResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListStaffsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    type ReportBenchmarkResultCallback = (error: (Error|null), response?: xsuportal.proto.services.bench.ReportBenchmarkResultResponse) => void;
-                }
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListStaffsResponse;
 
-                /** Properties of a ReportBenchmarkResultRequest. */
-                interface IReportBenchmarkResultRequest {
+                    /**
+                     * Verifies a ListStaffsResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
 
-                    /** ReportBenchmarkResultRequest jobId */
-                    jobId?: (number|Long|null);
+                    /**
+                     * Creates a ListStaffsResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListStaffsResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListStaffsResponse;
 
-                    /** ReportBenchmarkResultRequest handle */
-                    handle?: (string|null);
+                    /**
+                     * Creates a plain object from a ListStaffsResponse message. Also converts values to other types if specified.
+                     * @param message ListStaffsResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.ListStaffsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
-                    /** ReportBenchmarkResultRequest nonce */
-                    nonce?: (number|Long|null);
+                    /**
+                     * Converts this ListStaffsResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
 
-                    /** ReportBenchmarkResultRequest result */
-                    result?: (xsuportal.proto.resources.IBenchmarkResult|null);
+                /** Properties of an UpdateStaffRequest. */
+                interface IUpdateStaffRequest {
+
+                    /** UpdateStaffRequest githubLogin */
+                    githubLogin?: (string|null);
+
+                    /** UpdateStaffRequest role */
+                    role?: (string|null);
                 }
 
-                /** Represents a ReportBenchmarkResultRequest. */
-                class ReportBenchmarkResultRequest implements IReportBenchmarkResultRequest {
+                /** Represents an UpdateStaffRequest. */
+                class UpdateStaffRequest implements IUpdateStaffRequest {
 
                     /**
-                     * Constructs a new ReportBenchmarkResultRequest.
+                     * Constructs a new UpdateStaffRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultRequest);
-
-                    /** ReportBenchmarkResultRequest jobId. */
-                    public jobId: (number|Long);
-
-                    /** ReportBenchmarkResultRequest handle. */
-                    public handle: string;
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateStaffRequest);
 
-                    /** ReportBenchmarkResultRequest nonce. */
-                    public nonce: (number|Long);
+                    /** UpdateStaffRequest githubLogin. */
+                    public githubLogin: string;
 
-                    /** ReportBenchmarkResultRequest result. */
-                    public result?: (xsuportal.proto.resources.IBenchmarkResult|null);
+                    /** UpdateStaffRequest role. */
+                    public role: string;
 
                     /**
-                     * Creates a new ReportBenchmarkResultRequest instance using the specified properties.
+                     * Creates a new UpdateStaffRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ReportBenchmarkResultRequest instance
+                     * @returns UpdateStaffRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultRequest): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateStaffRequest): xsuportal.proto.services.admin.UpdateStaffRequest;
 
                     /**
-                     * Encodes the specified ReportBenchmarkResultRequest message. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultRequest.verify|verify} messages.
-                     * @param message ReportBenchmarkResultRequest message or plain object to encode
+                     * Encodes the specified UpdateStaffRequest message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateStaffRequest.verify|verify} messages.
+                     * @param message UpdateStaffRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateStaffRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ReportBenchmarkResultRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultRequest.verify|verify} messages.
-                     * @param message ReportBenchmarkResultRequest message or plain object to encode
+                     * Encodes the specified UpdateStaffRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateStaffRequest.verify|verify} messages.
+                     * @param message UpdateStaffRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateStaffRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ReportBenchmarkResultRequest message from the specified reader or buffer.
+                     * Decodes an UpdateStaffRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ReportBenchmarkResultRequest
+                     * @returns UpdateStaffRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateStaffRequest;
 
                     /**
-                     * Decodes a ReportBenchmarkResultRequest message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateStaffRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ReportBenchmarkResultRequest
+                     * @returns UpdateStaffRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateStaffRequest;
 
                     /**
-                     * Verifies a ReportBenchmarkResultRequest message.
+                     * Verifies an UpdateStaffRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ReportBenchmarkResultRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateStaffRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ReportBenchmarkResultRequest
+                     * @returns UpdateStaffRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateStaffRequest;
 
                     /**
-                     * Creates a plain object from a ReportBenchmarkResultRequest message. Also converts values to other types if specified.
-                     * @param message ReportBenchmarkResultRequest
+                     * Creates a plain object from an UpdateStaffRequest message. Also converts values to other types if specified.
+                     * @param message UpdateStaffRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.bench.ReportBenchmarkResultRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateStaffRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ReportBenchmarkResultRequest to JSON.
+                     * Converts this UpdateStaffRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ReportBenchmarkResultResponse. */
-                interface IReportBenchmarkResultResponse {
+                /** Properties of an UpdateStaffResponse. */
+                interface IUpdateStaffResponse {
 
-                    /** ReportBenchmarkResultResponse ackedNonce */
-                    ackedNonce?: (number|Long|null);
+                    /** UpdateStaffResponse staff */
+                    staff?: (xsuportal.proto.resources.IStaff|null);
                 }
 
-                /** Represents a ReportBenchmarkResultResponse. */
-                class ReportBenchmarkResultResponse implements IReportBenchmarkResultResponse {
+                /** Represents an UpdateStaffResponse. */
+                class UpdateStaffResponse implements IUpdateStaffResponse {
 
                     /**
-                     * Constructs a new ReportBenchmarkResultResponse.
+                     * Constructs a new UpdateStaffResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultResponse);
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateStaffResponse);
 
-                    /** ReportBenchmarkResultResponse ackedNonce. */
-                    public ackedNonce: (number|Long);
+                    /** UpdateStaffResponse staff. */
+                    public staff?: (xsuportal.proto.resources.IStaff|null);
 
                     /**
-                     * Creates a new ReportBenchmarkResultResponse instance using the specified properties.
+                     * Creates a new UpdateStaffResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ReportBenchmarkResultResponse instance
+                     * @returns UpdateStaffResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultResponse): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateStaffResponse): xsuportal.proto.services.admin.UpdateStaffResponse;
 
                     /**
-                     * Encodes the specified ReportBenchmarkResultResponse message. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultResponse.verify|verify} messages.
-                     * @param message ReportBenchmarkResultResponse message or plain object to encode
+                     * Encodes the specified UpdateStaffResponse message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateStaffResponse.verify|verify} messages.
+                     * @param message UpdateStaffResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.bench.IReportBenchmarkResultResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateStaffResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ReportBenchmarkResultResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultResponse.verify|verify} messages.
-                     * @param message ReportBenchmarkResultResponse message or plain object to encode
+                     * Encodes the specified UpdateStaffResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateStaffResponse.verify|verify} messages.
+                     * @param message UpdateStaffResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReportBenchmarkResultResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateStaffResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ReportBenchmarkResultResponse message from the specified reader or buffer.
+                     * Decodes an UpdateStaffResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ReportBenchmarkResultResponse
+                     * @returns UpdateStaffResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateStaffResponse;
 
                     /**
-                     * Decodes a ReportBenchmarkResultResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateStaffResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ReportBenchmarkResultResponse
+                     * @returns UpdateStaffResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateStaffResponse;
 
                     /**
-                     * Verifies a ReportBenchmarkResultResponse message.
+                     * Verifies an UpdateStaffResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ReportBenchmarkResultResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateStaffResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ReportBenchmarkResultResponse
+                     * @returns UpdateStaffResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateStaffResponse;
 
                     /**
-                     * Creates a plain object from a ReportBenchmarkResultResponse message. Also converts values to other types if specified.
-                     * @param message ReportBenchmarkResultResponse
+                     * Creates a plain object from an UpdateStaffResponse message. Also converts values to other types if specified.
+                     * @param message UpdateStaffResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.bench.ReportBenchmarkResultResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateStaffResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ReportBenchmarkResultResponse to JSON.
+                     * Converts this UpdateStaffResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
-            }
-
-            /** Namespace common. */
-            namespace common {
 
-                /** Properties of a GetCurrentSessionResponse. */
-                interface IGetCurrentSessionResponse {
-
-                    /** GetCurrentSessionResponse team */
-                    team?: (xsuportal.proto.resources.ITeam|null);
-
-                    /** GetCurrentSessionResponse contestant */
-                    contestant?: (xsuportal.proto.resources.IContestant|null);
-
-                    /** GetCurrentSessionResponse contest */
-                    contest?: (xsuportal.proto.resources.IContest|null);
-
-                    /** GetCurrentSessionResponse pushVapidKey */
-                    pushVapidKey?: (string|null);
+                /** Properties of a ListTeamsRequest. */
+                interface IListTeamsRequest {
                 }
 
-                /** Represents a GetCurrentSessionResponse. */
-                class GetCurrentSessionResponse implements IGetCurrentSessionResponse {
+                /** Represents a ListTeamsRequest. */
+                class ListTeamsRequest implements IListTeamsRequest {
 
                     /**
-                     * Constructs a new GetCurrentSessionResponse.
+                     * Constructs a new ListTeamsRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.common.IGetCurrentSessionResponse);
-
-                    /** GetCurrentSessionResponse team. */
-                    public team?: (xsuportal.proto.resources.ITeam|null);
-
-                    /** GetCurrentSessionResponse contestant. */
-                    public contestant?: (xsuportal.proto.resources.IContestant|null);
-
-                    /** GetCurrentSessionResponse contest. */
-                    public contest?: (xsuportal.proto.resources.IContest|null);
-
-                    /** GetCurrentSessionResponse pushVapidKey. */
-                    public pushVapidKey: string;
+                    constructor(properties?: xsuportal.proto.services.admin.IListTeamsRequest);
 
                     /**
-                     * Creates a new GetCurrentSessionResponse instance using the specified properties.
+                     * Creates a new ListTeamsRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns GetCurrentSessionResponse instance
+                     * @returns ListTeamsRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.common.IGetCurrentSessionResponse): xsuportal.proto.services.common.GetCurrentSessionResponse;
+                    public static create(properties?: xsuportal.proto.services.admin.IListTeamsRequest): xsuportal.proto.services.admin.ListTeamsRequest;
 
                     /**
-                     * Encodes the specified GetCurrentSessionResponse message. Does not implicitly {@link xsuportal.proto.services.common.GetCurrentSessionResponse.verify|verify} messages.
-                     * @param message GetCurrentSessionResponse message or plain object to encode
+                     * Encodes the specified ListTeamsRequest message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsRequest.verify|verify} messages.
+                     * @param message ListTeamsRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.common.IGetCurrentSessionResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.admin.IListTeamsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListTeamsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsRequest.verify|verify} messages.
+                     * @param message ListTeamsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListTeamsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListTeamsRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListTeamsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsRequest;
+
+                    /**
+                     * Decodes a ListTeamsRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListTeamsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsRequest;
+
+                    /**
+                     * Verifies a ListTeamsRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListTeamsRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListTeamsRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsRequest;
+
+                    /**
+                     * Creates a plain object from a ListTeamsRequest message. Also converts values to other types if specified.
+                     * @param message ListTeamsRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.ListTeamsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListTeamsRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ListTeamsResponse. */
+                interface IListTeamsResponse {
+
+                    /** ListTeamsResponse teams */
+                    teams?: (xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem[]|null);
+                }
+
+                /** Represents a ListTeamsResponse. */
+                class ListTeamsResponse implements IListTeamsResponse {
+
+                    /**
+                     * Constructs a new ListTeamsResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IListTeamsResponse);
+
+                    /** ListTeamsResponse teams. */
+                    public teams: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem[];
+
+                    /**
+                     * Creates a new ListTeamsResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListTeamsResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IListTeamsResponse): xsuportal.proto.services.admin.ListTeamsResponse;
+
+                    /**
+                     * Encodes the specified ListTeamsResponse message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.verify|verify} messages.
+                     * @param message ListTeamsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListTeamsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.verify|verify} messages.
+                     * @param message ListTeamsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListTeamsResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListTeamsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsResponse;
+
+                    /**
+                     * Decodes a ListTeamsResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListTeamsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsResponse;
+
+                    /**
+                     * Verifies a ListTeamsResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListTeamsResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListTeamsResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsResponse;
+
+                    /**
+                     * Creates a plain object from a ListTeamsResponse message. Also converts values to other types if specified.
+                     * @param message ListTeamsResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.ListTeamsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListTeamsResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                namespace ListTeamsResponse {
+
+                    /** Properties of a TeamListItem. */
+                    interface ITeamListItem {
+
+                        /** TeamListItem teamId */
+                        teamId?: (number|Long|null);
+
+                        /** TeamListItem name */
+                        name?: (string|null);
+
+                        /** TeamListItem memberNames */
+                        memberNames?: (string[]|null);
+
+                        /** TeamListItem isStudent */
+                        isStudent?: (boolean|null);
+
+                        /** TeamListItem withdrawn */
+                        withdrawn?: (boolean|null);
+                    }
+
+                    /** Represents a TeamListItem. */
+                    class TeamListItem implements ITeamListItem {
+
+                        /**
+                         * Constructs a new TeamListItem.
+                         * @param [properties] Properties to set
+                         */
+                        constructor(properties?: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem);
+
+                        /** TeamListItem teamId. */
+                        public teamId: (number|Long);
+
+                        /** TeamListItem name. */
+                        public name: string;
+
+                        /** TeamListItem memberNames. */
+                        public memberNames: string[];
+
+                        /** TeamListItem isStudent. */
+                        public isStudent: boolean;
+
+                        /** TeamListItem withdrawn. */
+                        public withdrawn: boolean;
+
+                        /**
+                         * Creates a new TeamListItem instance using the specified properties.
+                         * @param [properties] Properties to set
+                         * @returns TeamListItem instance
+                         */
+                        public static create(properties?: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Encodes the specified TeamListItem message. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem.verify|verify} messages.
+                         * @param message TeamListItem message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encode(message: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Encodes the specified TeamListItem message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem.verify|verify} messages.
+                         * @param message TeamListItem message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encodeDelimited(message: xsuportal.proto.services.admin.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Decodes a TeamListItem message from the specified reader or buffer.
+                         * @param reader Reader or buffer to decode from
+                         * @param [length] Message length if known beforehand
+                         * @returns TeamListItem
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Decodes a TeamListItem message from the specified reader or buffer, length delimited.
+                         * @param reader Reader or buffer to decode from
+                         * @returns TeamListItem
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Verifies a TeamListItem message.
+                         * @param message Plain object to verify
+                         * @returns `null` if valid, otherwise the reason why it is not
+                         */
+                        public static verify(message: { [k: string]: any }): (string|null);
+
+                        /**
+                         * Creates a TeamListItem message from a plain object. Also converts values to their respective internal types.
+                         * @param object Plain object
+                         * @returns TeamListItem
+                         */
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Creates a plain object from a TeamListItem message. Also converts values to other types if specified.
+                         * @param message TeamListItem
+                         * @param [options] Conversion options
+                         * @returns Plain object
+                         */
+                        public static toObject(message: xsuportal.proto.services.admin.ListTeamsResponse.TeamListItem, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                        /**
+                         * Converts this TeamListItem to JSON.
+                         * @returns JSON object
+                         */
+                        public toJSON(): { [k: string]: any };
+                    }
+                }
+
+                /** Properties of a GetTeamRequest. */
+                interface IGetTeamRequest {
+
+                    /** GetTeamRequest id */
+                    id?: (number|Long|null);
+                }
+
+                /** Represents a GetTeamRequest. */
+                class GetTeamRequest implements IGetTeamRequest {
+
+                    /**
+                     * Constructs a new GetTeamRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IGetTeamRequest);
+
+                    /** GetTeamRequest id. */
+                    public id: (number|Long);
+
+                    /**
+                     * Creates a new GetTeamRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns GetTeamRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IGetTeamRequest): xsuportal.proto.services.admin.GetTeamRequest;
+
+                    /**
+                     * Encodes the specified GetTeamRequest message. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamRequest.verify|verify} messages.
+                     * @param message GetTeamRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IGetTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified GetTeamRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamRequest.verify|verify} messages.
+                     * @param message GetTeamRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IGetTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a GetTeamRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns GetTeamRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.GetTeamRequest;
+
+                    /**
+                     * Decodes a GetTeamRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns GetTeamRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.GetTeamRequest;
+
+                    /**
+                     * Verifies a GetTeamRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a GetTeamRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns GetTeamRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.GetTeamRequest;
+
+                    /**
+                     * Creates a plain object from a GetTeamRequest message. Also converts values to other types if specified.
+                     * @param message GetTeamRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.GetTeamRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this GetTeamRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a GetTeamResponse. */
+                interface IGetTeamResponse {
+
+                    /** GetTeamResponse team */
+                    team?: (xsuportal.proto.resources.ITeam|null);
+                }
+
+                /** Represents a GetTeamResponse. */
+                class GetTeamResponse implements IGetTeamResponse {
+
+                    /**
+                     * Constructs a new GetTeamResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IGetTeamResponse);
+
+                    /** GetTeamResponse team. */
+                    public team?: (xsuportal.proto.resources.ITeam|null);
+
+                    /**
+                     * Creates a new GetTeamResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns GetTeamResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IGetTeamResponse): xsuportal.proto.services.admin.GetTeamResponse;
+
+                    /**
+                     * Encodes the specified GetTeamResponse message. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamResponse.verify|verify} messages.
+                     * @param message GetTeamResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IGetTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified GetTeamResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.GetTeamResponse.verify|verify} messages.
+                     * @param message GetTeamResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IGetTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a GetTeamResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns GetTeamResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.GetTeamResponse;
+
+                    /**
+                     * Decodes a GetTeamResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns GetTeamResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.GetTeamResponse;
+
+                    /**
+                     * Verifies a GetTeamResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a GetTeamResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns GetTeamResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.GetTeamResponse;
+
+                    /**
+                     * Creates a plain object from a GetTeamResponse message. Also converts values to other types if specified.
+                     * @param message GetTeamResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.GetTeamResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this GetTeamResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of an UpdateTeamRequest. */
+                interface IUpdateTeamRequest {
+
+                    /** UpdateTeamRequest team */
+                    team?: (xsuportal.proto.resources.ITeam|null);
+
+                    /** UpdateTeamRequest contestants */
+                    contestants?: (xsuportal.proto.resources.IContestant[]|null);
+                }
+
+                /** Represents an UpdateTeamRequest. */
+                class UpdateTeamRequest implements IUpdateTeamRequest {
+
+                    /**
+                     * Constructs a new UpdateTeamRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateTeamRequest);
+
+                    /** UpdateTeamRequest team. */
+                    public team?: (xsuportal.proto.resources.ITeam|null);
+
+                    /** UpdateTeamRequest contestants. */
+                    public contestants: xsuportal.proto.resources.IContestant[];
+
+                    /**
+                     * Creates a new UpdateTeamRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns UpdateTeamRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateTeamRequest): xsuportal.proto.services.admin.UpdateTeamRequest;
+
+                    /**
+                     * Encodes the specified UpdateTeamRequest message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamRequest.verify|verify} messages.
+                     * @param message UpdateTeamRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified UpdateTeamRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamRequest.verify|verify} messages.
+                     * @param message UpdateTeamRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateTeamRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes an UpdateTeamRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns UpdateTeamRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateTeamRequest;
+
+                    /**
+                     * Decodes an UpdateTeamRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns UpdateTeamRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateTeamRequest;
+
+                    /**
+                     * Verifies an UpdateTeamRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates an UpdateTeamRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns UpdateTeamRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateTeamRequest;
+
+                    /**
+                     * Creates a plain object from an UpdateTeamRequest message. Also converts values to other types if specified.
+                     * @param message UpdateTeamRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateTeamRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this UpdateTeamRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of an UpdateTeamResponse. */
+                interface IUpdateTeamResponse {
+                }
+
+                /** Represents an UpdateTeamResponse. */
+                class UpdateTeamResponse implements IUpdateTeamResponse {
+
+                    /**
+                     * Constructs a new UpdateTeamResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.admin.IUpdateTeamResponse);
+
+                    /**
+                     * Creates a new UpdateTeamResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns UpdateTeamResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.admin.IUpdateTeamResponse): xsuportal.proto.services.admin.UpdateTeamResponse;
+
+                    /**
+                     * Encodes the specified UpdateTeamResponse message. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamResponse.verify|verify} messages.
+                     * @param message UpdateTeamResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.admin.IUpdateTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified UpdateTeamResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.admin.UpdateTeamResponse.verify|verify} messages.
+                     * @param message UpdateTeamResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.admin.IUpdateTeamResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes an UpdateTeamResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns UpdateTeamResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.admin.UpdateTeamResponse;
+
+                    /**
+                     * Decodes an UpdateTeamResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns UpdateTeamResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.admin.UpdateTeamResponse;
+
+                    /**
+                     * Verifies an UpdateTeamResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates an UpdateTeamResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns UpdateTeamResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.admin.UpdateTeamResponse;
+
+                    /**
+                     * Creates a plain object from an UpdateTeamResponse message. Also converts values to other types if specified.
+                     * @param message UpdateTeamResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.admin.UpdateTeamResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this UpdateTeamResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+            }
+
+            /** Namespace audience. */
+            namespace audience {
+
+                /** Properties of a DashboardRequest. */
+                interface IDashboardRequest {
+                }
+
+                /** Represents a DashboardRequest. */
+                class DashboardRequest implements IDashboardRequest {
+
+                    /**
+                     * Constructs a new DashboardRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.audience.IDashboardRequest);
+
+                    /**
+                     * Creates a new DashboardRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns DashboardRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.audience.IDashboardRequest): xsuportal.proto.services.audience.DashboardRequest;
+
+                    /**
+                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.audience.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.audience.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.audience.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a DashboardRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns DashboardRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.DashboardRequest;
+
+                    /**
+                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns DashboardRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.DashboardRequest;
+
+                    /**
+                     * Verifies a DashboardRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns DashboardRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.DashboardRequest;
+
+                    /**
+                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
+                     * @param message DashboardRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.audience.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this DashboardRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a DashboardResponse. */
+                interface IDashboardResponse {
+
+                    /** DashboardResponse leaderboard */
+                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                }
+
+                /** Represents a DashboardResponse. */
+                class DashboardResponse implements IDashboardResponse {
+
+                    /**
+                     * Constructs a new DashboardResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.audience.IDashboardResponse);
+
+                    /** DashboardResponse leaderboard. */
+                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+
+                    /**
+                     * Creates a new DashboardResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns DashboardResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.audience.IDashboardResponse): xsuportal.proto.services.audience.DashboardResponse;
+
+                    /**
+                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.audience.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.audience.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.audience.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a DashboardResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns DashboardResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.DashboardResponse;
+
+                    /**
+                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns DashboardResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.DashboardResponse;
+
+                    /**
+                     * Verifies a DashboardResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns DashboardResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.DashboardResponse;
+
+                    /**
+                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
+                     * @param message DashboardResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.audience.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this DashboardResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ListTeamsResponse. */
+                interface IListTeamsResponse {
+
+                    /** ListTeamsResponse teams */
+                    teams?: (xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem[]|null);
+                }
+
+                /** Represents a ListTeamsResponse. */
+                class ListTeamsResponse implements IListTeamsResponse {
+
+                    /**
+                     * Constructs a new ListTeamsResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.audience.IListTeamsResponse);
+
+                    /** ListTeamsResponse teams. */
+                    public teams: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem[];
+
+                    /**
+                     * Creates a new ListTeamsResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListTeamsResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.audience.IListTeamsResponse): xsuportal.proto.services.audience.ListTeamsResponse;
+
+                    /**
+                     * Encodes the specified ListTeamsResponse message. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.verify|verify} messages.
+                     * @param message ListTeamsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.audience.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListTeamsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.verify|verify} messages.
+                     * @param message ListTeamsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.audience.IListTeamsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListTeamsResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListTeamsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.ListTeamsResponse;
+
+                    /**
+                     * Decodes a ListTeamsResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListTeamsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.ListTeamsResponse;
+
+                    /**
+                     * Verifies a ListTeamsResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListTeamsResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListTeamsResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.ListTeamsResponse;
+
+                    /**
+                     * Creates a plain object from a ListTeamsResponse message. Also converts values to other types if specified.
+                     * @param message ListTeamsResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.audience.ListTeamsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListTeamsResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                namespace ListTeamsResponse {
+
+                    /** Properties of a TeamListItem. */
+                    interface ITeamListItem {
+
+                        /** TeamListItem teamId */
+                        teamId?: (number|Long|null);
+
+                        /** TeamListItem name */
+                        name?: (string|null);
+
+                        /** TeamListItem memberNames */
+                        memberNames?: (string[]|null);
+
+                        /** TeamListItem isStudent */
+                        isStudent?: (boolean|null);
+                    }
+
+                    /** Represents a TeamListItem. */
+                    class TeamListItem implements ITeamListItem {
+
+                        /**
+                         * Constructs a new TeamListItem.
+                         * @param [properties] Properties to set
+                         */
+                        constructor(properties?: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem);
+
+                        /** TeamListItem teamId. */
+                        public teamId: (number|Long);
+
+                        /** TeamListItem name. */
+                        public name: string;
+
+                        /** TeamListItem memberNames. */
+                        public memberNames: string[];
+
+                        /** TeamListItem isStudent. */
+                        public isStudent: boolean;
+
+                        /**
+                         * Creates a new TeamListItem instance using the specified properties.
+                         * @param [properties] Properties to set
+                         * @returns TeamListItem instance
+                         */
+                        public static create(properties?: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Encodes the specified TeamListItem message. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem.verify|verify} messages.
+                         * @param message TeamListItem message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encode(message: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Encodes the specified TeamListItem message, length delimited. Does not implicitly {@link xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem.verify|verify} messages.
+                         * @param message TeamListItem message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encodeDelimited(message: xsuportal.proto.services.audience.ListTeamsResponse.ITeamListItem, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Decodes a TeamListItem message from the specified reader or buffer.
+                         * @param reader Reader or buffer to decode from
+                         * @param [length] Message length if known beforehand
+                         * @returns TeamListItem
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Decodes a TeamListItem message from the specified reader or buffer, length delimited.
+                         * @param reader Reader or buffer to decode from
+                         * @returns TeamListItem
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Verifies a TeamListItem message.
+                         * @param message Plain object to verify
+                         * @returns `null` if valid, otherwise the reason why it is not
+                         */
+                        public static verify(message: { [k: string]: any }): (string|null);
+
+                        /**
+                         * Creates a TeamListItem message from a plain object. Also converts values to their respective internal types.
+                         * @param object Plain object
+                         * @returns TeamListItem
+                         */
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem;
+
+                        /**
+                         * Creates a plain object from a TeamListItem message. Also converts values to other types if specified.
+                         * @param message TeamListItem
+                         * @param [options] Conversion options
+                         * @returns Plain object
+                         */
+                        public static toObject(message: xsuportal.proto.services.audience.ListTeamsResponse.TeamListItem, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                        /**
+                         * Converts this TeamListItem to JSON.
+                         * @returns JSON object
+                         */
+                        public toJSON(): { [k: string]: any };
+                    }
+                }
+            }
+
+            /** Namespace bench. */
+            namespace bench {
+
+                /** Represents a BenchmarkQueue */
+                class BenchmarkQueue extends $protobuf.rpc.Service {
+
+                    /**
+                     * Constructs a new BenchmarkQueue service.
+                     * @param rpcImpl RPC implementation
+                     * @param [requestDelimited=false] Whether requests are length-delimited
+                     * @param [responseDelimited=false] Whether responses are length-delimited
+                     */
+                    constructor(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean);
+
+                    /**
+                     * Creates new BenchmarkQueue service using the specified rpc implementation.
+                     * @param rpcImpl RPC implementation
+                     * @param [requestDelimited=false] Whether requests are length-delimited
+                     * @param [responseDelimited=false] Whether responses are length-delimited
+                     * @returns RPC service. Useful where requests and/or responses are streamed.
+                     */
+                    public static create(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean): BenchmarkQueue;
+
+                    /**
+                     * Calls ReceiveBenchmarkJob.
+                     * @param request ReceiveBenchmarkJobRequest message or plain object
+                     * @param callback Node-style callback called with the error, if any, and ReceiveBenchmarkJobResponse
+                     */
+                    public receiveBenchmarkJob(request: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, callback: xsuportal.proto.services.bench.BenchmarkQueue.ReceiveBenchmarkJobCallback): void;
+
+                    /**
+                     * Calls ReceiveBenchmarkJob.
+                     * @param request ReceiveBenchmarkJobRequest message or plain object
+                     * @returns Promise
+                     */
+                    public receiveBenchmarkJob(request: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest): Promise<xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse>;
+                }
+
+                namespace BenchmarkQueue {
+
+                    /**
+                     * Callback as used by {@link xsuportal.proto.services.bench.BenchmarkQueue#receiveBenchmarkJob}.
+                     * @param error Error, if any
+                     * @param [response] ReceiveBenchmarkJobResponse
+                     */
+                    type ReceiveBenchmarkJobCallback = (error: (Error|null), response?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse) => void;
+                }
+
+                /** Properties of a ReceiveBenchmarkJobRequest. */
+                interface IReceiveBenchmarkJobRequest {
+
+                    /** ReceiveBenchmarkJobRequest teamId */
+                    teamId?: (number|Long|null);
+                }
+
+                /** Represents a ReceiveBenchmarkJobRequest. */
+                class ReceiveBenchmarkJobRequest implements IReceiveBenchmarkJobRequest {
+
+                    /**
+                     * Constructs a new ReceiveBenchmarkJobRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest);
+
+                    /** ReceiveBenchmarkJobRequest teamId. */
+                    public teamId: (number|Long);
+
+                    /**
+                     * Creates a new ReceiveBenchmarkJobRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ReceiveBenchmarkJobRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+
+                    /**
+                     * Encodes the specified ReceiveBenchmarkJobRequest message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest.verify|verify} messages.
+                     * @param message ReceiveBenchmarkJobRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ReceiveBenchmarkJobRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest.verify|verify} messages.
+                     * @param message ReceiveBenchmarkJobRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ReceiveBenchmarkJobRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ReceiveBenchmarkJobRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+
+                    /**
+                     * Decodes a ReceiveBenchmarkJobRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ReceiveBenchmarkJobRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+
+                    /**
+                     * Verifies a ReceiveBenchmarkJobRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ReceiveBenchmarkJobRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ReceiveBenchmarkJobRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest;
+
+                    /**
+                     * Creates a plain object from a ReceiveBenchmarkJobRequest message. Also converts values to other types if specified.
+                     * @param message ReceiveBenchmarkJobRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ReceiveBenchmarkJobRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ReceiveBenchmarkJobResponse. */
+                interface IReceiveBenchmarkJobResponse {
+
+                    /** ReceiveBenchmarkJobResponse jobHandle */
+                    jobHandle?: (xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle|null);
+                }
+
+                /** Represents a ReceiveBenchmarkJobResponse. */
+                class ReceiveBenchmarkJobResponse implements IReceiveBenchmarkJobResponse {
+
+                    /**
+                     * Constructs a new ReceiveBenchmarkJobResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse);
+
+                    /** ReceiveBenchmarkJobResponse jobHandle. */
+                    public jobHandle?: (xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle|null);
+
+                    /**
+                     * Creates a new ReceiveBenchmarkJobResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ReceiveBenchmarkJobResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+
+                    /**
+                     * Encodes the specified ReceiveBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.verify|verify} messages.
+                     * @param message ReceiveBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ReceiveBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.verify|verify} messages.
+                     * @param message ReceiveBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReceiveBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ReceiveBenchmarkJobResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ReceiveBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+
+                    /**
+                     * Decodes a ReceiveBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ReceiveBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+
+                    /**
+                     * Verifies a ReceiveBenchmarkJobResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ReceiveBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ReceiveBenchmarkJobResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse;
+
+                    /**
+                     * Creates a plain object from a ReceiveBenchmarkJobResponse message. Also converts values to other types if specified.
+                     * @param message ReceiveBenchmarkJobResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ReceiveBenchmarkJobResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                namespace ReceiveBenchmarkJobResponse {
+
+                    /** Properties of a JobHandle. */
+                    interface IJobHandle {
+
+                        /** JobHandle jobId */
+                        jobId?: (number|Long|null);
+
+                        /** JobHandle handle */
+                        handle?: (string|null);
+
+                        /** JobHandle targetHostname */
+                        targetHostname?: (string|null);
+
+                        /** JobHandle contestStartedAt */
+                        contestStartedAt?: (google.protobuf.ITimestamp|null);
+
+                        /** JobHandle jobCreatedAt */
+                        jobCreatedAt?: (google.protobuf.ITimestamp|null);
+                    }
+
+                    /** Represents a JobHandle. */
+                    class JobHandle implements IJobHandle {
+
+                        /**
+                         * Constructs a new JobHandle.
+                         * @param [properties] Properties to set
+                         */
+                        constructor(properties?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle);
+
+                        /** JobHandle jobId. */
+                        public jobId: (number|Long);
+
+                        /** JobHandle handle. */
+                        public handle: string;
+
+                        /** JobHandle targetHostname. */
+                        public targetHostname: string;
+
+                        /** JobHandle contestStartedAt. */
+                        public contestStartedAt?: (google.protobuf.ITimestamp|null);
+
+                        /** JobHandle jobCreatedAt. */
+                        public jobCreatedAt?: (google.protobuf.ITimestamp|null);
+
+                        /**
+                         * Creates a new JobHandle instance using the specified properties.
+                         * @param [properties] Properties to set
+                         * @returns JobHandle instance
+                         */
+                        public static create(properties?: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+
+                        /**
+                         * Encodes the specified JobHandle message. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle.verify|verify} messages.
+                         * @param message JobHandle message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encode(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Encodes the specified JobHandle message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle.verify|verify} messages.
+                         * @param message JobHandle message or plain object to encode
+                         * @param [writer] Writer to encode to
+                         * @returns Writer
+                         */
+                        public static encodeDelimited(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.IJobHandle, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                        /**
+                         * Decodes a JobHandle message from the specified reader or buffer.
+                         * @param reader Reader or buffer to decode from
+                         * @param [length] Message length if known beforehand
+                         * @returns JobHandle
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+
+                        /**
+                         * Decodes a JobHandle message from the specified reader or buffer, length delimited.
+                         * @param reader Reader or buffer to decode from
+                         * @returns JobHandle
+                         * @throws {Error} If the payload is not a reader or valid buffer
+                         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                         */
+                        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+
+                        /**
+                         * Verifies a JobHandle message.
+                         * @param message Plain object to verify
+                         * @returns `null` if valid, otherwise the reason why it is not
+                         */
+                        public static verify(message: { [k: string]: any }): (string|null);
+
+                        /**
+                         * Creates a JobHandle message from a plain object. Also converts values to their respective internal types.
+                         * @param object Plain object
+                         * @returns JobHandle
+                         */
+                        public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle;
+
+                        /**
+                         * Creates a plain object from a JobHandle message. Also converts values to other types if specified.
+                         * @param message JobHandle
+                         * @param [options] Conversion options
+                         * @returns Plain object
+                         */
+                        public static toObject(message: xsuportal.proto.services.bench.ReceiveBenchmarkJobResponse.JobHandle, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                        /**
+                         * Converts this JobHandle to JSON.
+                         * @returns JSON object
+                         */
+                        public toJSON(): { [k: string]: any };
+                    }
+                }
+
+                /** Represents a BenchmarkReport */
+                class BenchmarkReport extends $protobuf.rpc.Service {
+
+                    /**
+                     * Constructs a new BenchmarkReport service.
+                     * @param rpcImpl RPC implementation
+                     * @param [requestDelimited=false] Whether requests are length-delimited
+                     * @param [responseDelimited=false] Whether responses are length-delimited
+                     */
+                    constructor(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean);
+
+                    /**
+                     * Creates new BenchmarkReport service using the specified rpc implementation.
+                     * @param rpcImpl RPC implementation
+                     * @param [requestDelimited=false] Whether requests are length-delimited
+                     * @param [responseDelimited=false] Whether responses are length-delimited
+                     * @returns RPC service. Useful where requests and/or responses are streamed.
+                     */
+                    public static create(rpcImpl: $protobuf.RPCImpl, requestDelimited?: boolean, responseDelimited?: boolean): BenchmarkReport;
+
+                    /**
+                     * Calls ReportBenchmarkResult.
+                     * @param request ReportBenchmarkResultRequest message or plain object
+                     * @param callback Node-style callback called with the error, if any, and ReportBenchmarkResultResponse
+                     */
+                    public reportBenchmarkResult(request: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, callback: xsuportal.proto.services.bench.BenchmarkReport.ReportBenchmarkResultCallback): void;
+
+                    /**
+                     * Calls ReportBenchmarkResult.
+                     * @param request ReportBenchmarkResultRequest message or plain object
+                     * @returns Promise
+                     */
+                    public reportBenchmarkResult(request: xsuportal.proto.services.bench.IReportBenchmarkResultRequest): Promise<xsuportal.proto.services.bench.ReportBenchmarkResultResponse>;
+                }
+
+                namespace BenchmarkReport {
+
+                    /**
+                     * Callback as used by {@link xsuportal.proto.services.bench.BenchmarkReport#reportBenchmarkResult}.
+                     * @param error Error, if any
+                     * @param [response] ReportBenchmarkResultResponse
+                     */
+                    type ReportBenchmarkResultCallback = (error: (Error|null), response?: xsuportal.proto.services.bench.ReportBenchmarkResultResponse) => void;
+                }
+
+                /** Properties of a ReportBenchmarkResultRequest. */
+                interface IReportBenchmarkResultRequest {
+
+                    /** ReportBenchmarkResultRequest jobId */
+                    jobId?: (number|Long|null);
+
+                    /** ReportBenchmarkResultRequest handle */
+                    handle?: (string|null);
+
+                    /** ReportBenchmarkResultRequest nonce */
+                    nonce?: (number|Long|null);
+
+                    /** ReportBenchmarkResultRequest result */
+                    result?: (xsuportal.proto.resources.IBenchmarkResult|null);
+                }
+
+                /** Represents a ReportBenchmarkResultRequest. */
+                class ReportBenchmarkResultRequest implements IReportBenchmarkResultRequest {
+
+                    /**
+                     * Constructs a new ReportBenchmarkResultRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultRequest);
+
+                    /** ReportBenchmarkResultRequest jobId. */
+                    public jobId: (number|Long);
+
+                    /** ReportBenchmarkResultRequest handle. */
+                    public handle: string;
+
+                    /** ReportBenchmarkResultRequest nonce. */
+                    public nonce: (number|Long);
+
+                    /** ReportBenchmarkResultRequest result. */
+                    public result?: (xsuportal.proto.resources.IBenchmarkResult|null);
+
+                    /**
+                     * Creates a new ReportBenchmarkResultRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ReportBenchmarkResultRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultRequest): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+
+                    /**
+                     * Encodes the specified ReportBenchmarkResultRequest message. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultRequest.verify|verify} messages.
+                     * @param message ReportBenchmarkResultRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ReportBenchmarkResultRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultRequest.verify|verify} messages.
+                     * @param message ReportBenchmarkResultRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReportBenchmarkResultRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ReportBenchmarkResultRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ReportBenchmarkResultRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+
+                    /**
+                     * Decodes a ReportBenchmarkResultRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ReportBenchmarkResultRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+
+                    /**
+                     * Verifies a ReportBenchmarkResultRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ReportBenchmarkResultRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ReportBenchmarkResultRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReportBenchmarkResultRequest;
+
+                    /**
+                     * Creates a plain object from a ReportBenchmarkResultRequest message. Also converts values to other types if specified.
+                     * @param message ReportBenchmarkResultRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.bench.ReportBenchmarkResultRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ReportBenchmarkResultRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ReportBenchmarkResultResponse. */
+                interface IReportBenchmarkResultResponse {
+
+                    /** ReportBenchmarkResultResponse ackedNonce */
+                    ackedNonce?: (number|Long|null);
+                }
+
+                /** Represents a ReportBenchmarkResultResponse. */
+                class ReportBenchmarkResultResponse implements IReportBenchmarkResultResponse {
+
+                    /**
+                     * Constructs a new ReportBenchmarkResultResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultResponse);
+
+                    /** ReportBenchmarkResultResponse ackedNonce. */
+                    public ackedNonce: (number|Long);
+
+                    /**
+                     * Creates a new ReportBenchmarkResultResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ReportBenchmarkResultResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.bench.IReportBenchmarkResultResponse): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+
+                    /**
+                     * Encodes the specified ReportBenchmarkResultResponse message. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultResponse.verify|verify} messages.
+                     * @param message ReportBenchmarkResultResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.bench.IReportBenchmarkResultResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ReportBenchmarkResultResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.bench.ReportBenchmarkResultResponse.verify|verify} messages.
+                     * @param message ReportBenchmarkResultResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.bench.IReportBenchmarkResultResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ReportBenchmarkResultResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ReportBenchmarkResultResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+
+                    /**
+                     * Decodes a ReportBenchmarkResultResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ReportBenchmarkResultResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+
+                    /**
+                     * Verifies a ReportBenchmarkResultResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ReportBenchmarkResultResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ReportBenchmarkResultResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.bench.ReportBenchmarkResultResponse;
+
+                    /**
+                     * Creates a plain object from a ReportBenchmarkResultResponse message. Also converts values to other types if specified.
+                     * @param message ReportBenchmarkResultResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.bench.ReportBenchmarkResultResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ReportBenchmarkResultResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+            }
+
+            /** Namespace common. */
+            namespace common {
+
+                /** Properties of a GetCurrentSessionResponse. */
+                interface IGetCurrentSessionResponse {
+
+                    /** GetCurrentSessionResponse team */
+                    team?: (xsuportal.proto.resources.ITeam|null);
+
+                    /** GetCurrentSessionResponse contestant */
+                    contestant?: (xsuportal.proto.resources.IContestant|null);
+
+                    /** GetCurrentSessionResponse contest */
+                    contest?: (xsuportal.proto.resources.IContest|null);
+
+                    /** GetCurrentSessionResponse pushVapidKey */
+                    pushVapidKey?: (string|null);
+                }
+
+                /** Represents a GetCurrentSessionResponse. */
+                class GetCurrentSessionResponse implements IGetCurrentSessionResponse {
+
+                    /**
+                     * Constructs a new GetCurrentSessionResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.common.IGetCurrentSessionResponse);
+
+                    /** GetCurrentSessionResponse team. */
+                    public team?: (xsuportal.proto.resources.ITeam|null);
+
+                    /** GetCurrentSessionResponse contestant. */
+                    public contestant?: (xsuportal.proto.resources.IContestant|null);
+
+                    /** GetCurrentSessionResponse contest. */
+                    public contest?: (xsuportal.proto.resources.IContest|null);
+
+                    /** GetCurrentSessionResponse pushVapidKey. */
+                    public pushVapidKey: string;
+
+                    /**
+                     * Creates a new GetCurrentSessionResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns GetCurrentSessionResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.common.IGetCurrentSessionResponse): xsuportal.proto.services.common.GetCurrentSessionResponse;
+
+                    /**
+                     * Encodes the specified GetCurrentSessionResponse message. Does not implicitly {@link xsuportal.proto.services.common.GetCurrentSessionResponse.verify|verify} messages.
+                     * @param message GetCurrentSessionResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.common.IGetCurrentSessionResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified GetCurrentSessionResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.common.GetCurrentSessionResponse.verify|verify} messages.
+                     * @param message GetCurrentSessionResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.common.IGetCurrentSessionResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a GetCurrentSessionResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns GetCurrentSessionResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.common.GetCurrentSessionResponse;
+
+                    /**
+                     * Decodes a GetCurrentSessionResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns GetCurrentSessionResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.common.GetCurrentSessionResponse;
+
+                    /**
+                     * Verifies a GetCurrentSessionResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a GetCurrentSessionResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns GetCurrentSessionResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.common.GetCurrentSessionResponse;
+
+                    /**
+                     * Creates a plain object from a GetCurrentSessionResponse message. Also converts values to other types if specified.
+                     * @param message GetCurrentSessionResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.common.GetCurrentSessionResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this GetCurrentSessionResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+            }
+
+            /** Namespace contestant. */
+            namespace contestant {
+
+                /** Properties of a ListBenchmarkJobsRequest. */
+                interface IListBenchmarkJobsRequest {
+                }
+
+                /** Represents a ListBenchmarkJobsRequest. */
+                class ListBenchmarkJobsRequest implements IListBenchmarkJobsRequest {
+
+                    /**
+                     * Constructs a new ListBenchmarkJobsRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest);
+
+                    /**
+                     * Creates a new ListBenchmarkJobsRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListBenchmarkJobsRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+
+                    /**
+                     * Encodes the specified ListBenchmarkJobsRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsRequest.verify|verify} messages.
+                     * @param message ListBenchmarkJobsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListBenchmarkJobsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsRequest.verify|verify} messages.
+                     * @param message ListBenchmarkJobsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListBenchmarkJobsRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListBenchmarkJobsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+
+                    /**
+                     * Decodes a ListBenchmarkJobsRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListBenchmarkJobsRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+
+                    /**
+                     * Verifies a ListBenchmarkJobsRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListBenchmarkJobsRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListBenchmarkJobsRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+
+                    /**
+                     * Creates a plain object from a ListBenchmarkJobsRequest message. Also converts values to other types if specified.
+                     * @param message ListBenchmarkJobsRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.ListBenchmarkJobsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListBenchmarkJobsRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ListBenchmarkJobsResponse. */
+                interface IListBenchmarkJobsResponse {
+
+                    /** ListBenchmarkJobsResponse jobs */
+                    jobs?: (xsuportal.proto.resources.IBenchmarkJob[]|null);
+                }
+
+                /** Represents a ListBenchmarkJobsResponse. */
+                class ListBenchmarkJobsResponse implements IListBenchmarkJobsResponse {
+
+                    /**
+                     * Constructs a new ListBenchmarkJobsResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse);
+
+                    /** ListBenchmarkJobsResponse jobs. */
+                    public jobs: xsuportal.proto.resources.IBenchmarkJob[];
+
+                    /**
+                     * Creates a new ListBenchmarkJobsResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListBenchmarkJobsResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+
+                    /**
+                     * Encodes the specified ListBenchmarkJobsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsResponse.verify|verify} messages.
+                     * @param message ListBenchmarkJobsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified ListBenchmarkJobsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsResponse.verify|verify} messages.
+                     * @param message ListBenchmarkJobsResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a ListBenchmarkJobsResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns ListBenchmarkJobsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+
+                    /**
+                     * Decodes a ListBenchmarkJobsResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns ListBenchmarkJobsResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+
+                    /**
+                     * Verifies a ListBenchmarkJobsResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a ListBenchmarkJobsResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns ListBenchmarkJobsResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+
+                    /**
+                     * Creates a plain object from a ListBenchmarkJobsResponse message. Also converts values to other types if specified.
+                     * @param message ListBenchmarkJobsResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.ListBenchmarkJobsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this ListBenchmarkJobsResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of an EnqueueBenchmarkJobRequest. */
+                interface IEnqueueBenchmarkJobRequest {
+
+                    /** EnqueueBenchmarkJobRequest targetHostname */
+                    targetHostname?: (string|null);
+                }
+
+                /** Represents an EnqueueBenchmarkJobRequest. */
+                class EnqueueBenchmarkJobRequest implements IEnqueueBenchmarkJobRequest {
+
+                    /**
+                     * Constructs a new EnqueueBenchmarkJobRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest);
+
+                    /** EnqueueBenchmarkJobRequest targetHostname. */
+                    public targetHostname: string;
+
+                    /**
+                     * Creates a new EnqueueBenchmarkJobRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns EnqueueBenchmarkJobRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+
+                    /**
+                     * Encodes the specified EnqueueBenchmarkJobRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest.verify|verify} messages.
+                     * @param message EnqueueBenchmarkJobRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified EnqueueBenchmarkJobRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest.verify|verify} messages.
+                     * @param message EnqueueBenchmarkJobRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes an EnqueueBenchmarkJobRequest message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns EnqueueBenchmarkJobRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+
+                    /**
+                     * Decodes an EnqueueBenchmarkJobRequest message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns EnqueueBenchmarkJobRequest
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+
+                    /**
+                     * Verifies an EnqueueBenchmarkJobRequest message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates an EnqueueBenchmarkJobRequest message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns EnqueueBenchmarkJobRequest
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+
+                    /**
+                     * Creates a plain object from an EnqueueBenchmarkJobRequest message. Also converts values to other types if specified.
+                     * @param message EnqueueBenchmarkJobRequest
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this EnqueueBenchmarkJobRequest to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of an EnqueueBenchmarkJobResponse. */
+                interface IEnqueueBenchmarkJobResponse {
+
+                    /** EnqueueBenchmarkJobResponse job */
+                    job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+                }
+
+                /** Represents an EnqueueBenchmarkJobResponse. */
+                class EnqueueBenchmarkJobResponse implements IEnqueueBenchmarkJobResponse {
+
+                    /**
+                     * Constructs a new EnqueueBenchmarkJobResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse);
+
+                    /** EnqueueBenchmarkJobResponse job. */
+                    public job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+
+                    /**
+                     * Creates a new EnqueueBenchmarkJobResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns EnqueueBenchmarkJobResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+
+                    /**
+                     * Encodes the specified EnqueueBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse.verify|verify} messages.
+                     * @param message EnqueueBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified EnqueueBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse.verify|verify} messages.
+                     * @param message EnqueueBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes an EnqueueBenchmarkJobResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns EnqueueBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+
+                    /**
+                     * Decodes an EnqueueBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns EnqueueBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+
+                    /**
+                     * Verifies an EnqueueBenchmarkJobResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates an EnqueueBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns EnqueueBenchmarkJobResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+
+                    /**
+                     * Creates a plain object from an EnqueueBenchmarkJobResponse message. Also converts values to other types if specified.
+                     * @param message EnqueueBenchmarkJobResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this EnqueueBenchmarkJobResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a GetBenchmarkJobQuery. */
+                interface IGetBenchmarkJobQuery {
+
+                    /** GetBenchmarkJobQuery id */
+                    id?: (number|Long|null);
+                }
+
+                /** Represents a GetBenchmarkJobQuery. */
+                class GetBenchmarkJobQuery implements IGetBenchmarkJobQuery {
+
+                    /**
+                     * Constructs a new GetBenchmarkJobQuery.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery);
+
+                    /** GetBenchmarkJobQuery id. */
+                    public id: (number|Long);
+
+                    /**
+                     * Creates a new GetBenchmarkJobQuery instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns GetBenchmarkJobQuery instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+
+                    /**
+                     * Encodes the specified GetBenchmarkJobQuery message. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobQuery.verify|verify} messages.
+                     * @param message GetBenchmarkJobQuery message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified GetBenchmarkJobQuery message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobQuery.verify|verify} messages.
+                     * @param message GetBenchmarkJobQuery message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a GetBenchmarkJobQuery message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns GetBenchmarkJobQuery
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+
+                    /**
+                     * Decodes a GetBenchmarkJobQuery message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns GetBenchmarkJobQuery
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+
+                    /**
+                     * Verifies a GetBenchmarkJobQuery message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a GetBenchmarkJobQuery message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns GetBenchmarkJobQuery
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+
+                    /**
+                     * Creates a plain object from a GetBenchmarkJobQuery message. Also converts values to other types if specified.
+                     * @param message GetBenchmarkJobQuery
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.GetBenchmarkJobQuery, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this GetBenchmarkJobQuery to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a GetBenchmarkJobResponse. */
+                interface IGetBenchmarkJobResponse {
+
+                    /** GetBenchmarkJobResponse job */
+                    job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+
+                    /** GetBenchmarkJobResponse progresses */
+                    progresses?: (xsuportal.proto.resources.IBenchmarkResult[]|null);
+                }
+
+                /** Represents a GetBenchmarkJobResponse. */
+                class GetBenchmarkJobResponse implements IGetBenchmarkJobResponse {
+
+                    /**
+                     * Constructs a new GetBenchmarkJobResponse.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse);
+
+                    /** GetBenchmarkJobResponse job. */
+                    public job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+
+                    /** GetBenchmarkJobResponse progresses. */
+                    public progresses: xsuportal.proto.resources.IBenchmarkResult[];
+
+                    /**
+                     * Creates a new GetBenchmarkJobResponse instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns GetBenchmarkJobResponse instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+
+                    /**
+                     * Encodes the specified GetBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobResponse.verify|verify} messages.
+                     * @param message GetBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Encodes the specified GetBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobResponse.verify|verify} messages.
+                     * @param message GetBenchmarkJobResponse message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+                    /**
+                     * Decodes a GetBenchmarkJobResponse message from the specified reader or buffer.
+                     * @param reader Reader or buffer to decode from
+                     * @param [length] Message length if known beforehand
+                     * @returns GetBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+
+                    /**
+                     * Decodes a GetBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * @param reader Reader or buffer to decode from
+                     * @returns GetBenchmarkJobResponse
+                     * @throws {Error} If the payload is not a reader or valid buffer
+                     * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                     */
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+
+                    /**
+                     * Verifies a GetBenchmarkJobResponse message.
+                     * @param message Plain object to verify
+                     * @returns `null` if valid, otherwise the reason why it is not
+                     */
+                    public static verify(message: { [k: string]: any }): (string|null);
+
+                    /**
+                     * Creates a GetBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * @param object Plain object
+                     * @returns GetBenchmarkJobResponse
+                     */
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+
+                    /**
+                     * Creates a plain object from a GetBenchmarkJobResponse message. Also converts values to other types if specified.
+                     * @param message GetBenchmarkJobResponse
+                     * @param [options] Conversion options
+                     * @returns Plain object
+                     */
+                    public static toObject(message: xsuportal.proto.services.contestant.GetBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+                    /**
+                     * Converts this GetBenchmarkJobResponse to JSON.
+                     * @returns JSON object
+                     */
+                    public toJSON(): { [k: string]: any };
+                }
+
+                /** Properties of a ListClarificationsRequest. */
+                interface IListClarificationsRequest {
+                }
+
+                /** Represents a ListClarificationsRequest. */
+                class ListClarificationsRequest implements IListClarificationsRequest {
+
+                    /**
+                     * Constructs a new ListClarificationsRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.IListClarificationsRequest);
+
+                    /**
+                     * Creates a new ListClarificationsRequest instance using the specified properties.
+                     * @param [properties] Properties to set
+                     * @returns ListClarificationsRequest instance
+                     */
+                    public static create(properties?: xsuportal.proto.services.contestant.IListClarificationsRequest): xsuportal.proto.services.contestant.ListClarificationsRequest;
+
+                    /**
+                     * Encodes the specified ListClarificationsRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsRequest.verify|verify} messages.
+                     * @param message ListClarificationsRequest message or plain object to encode
+                     * @param [writer] Writer to encode to
+                     * @returns Writer
+                     */
+                    public static encode(message: xsuportal.proto.services.contestant.IListClarificationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified GetCurrentSessionResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.common.GetCurrentSessionResponse.verify|verify} messages.
-                     * @param message GetCurrentSessionResponse message or plain object to encode
+                     * Encodes the specified ListClarificationsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsRequest.verify|verify} messages.
+                     * @param message ListClarificationsRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.common.IGetCurrentSessionResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListClarificationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a GetCurrentSessionResponse message from the specified reader or buffer.
+                     * Decodes a ListClarificationsRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns GetCurrentSessionResponse
+                     * @returns ListClarificationsRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.common.GetCurrentSessionResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListClarificationsRequest;
 
                     /**
-                     * Decodes a GetCurrentSessionResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a ListClarificationsRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns GetCurrentSessionResponse
+                     * @returns ListClarificationsRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.common.GetCurrentSessionResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListClarificationsRequest;
 
                     /**
-                     * Verifies a GetCurrentSessionResponse message.
+                     * Verifies a ListClarificationsRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a GetCurrentSessionResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListClarificationsRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns GetCurrentSessionResponse
+                     * @returns ListClarificationsRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.common.GetCurrentSessionResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListClarificationsRequest;
 
                     /**
-                     * Creates a plain object from a GetCurrentSessionResponse message. Also converts values to other types if specified.
-                     * @param message GetCurrentSessionResponse
+                     * Creates a plain object from a ListClarificationsRequest message. Also converts values to other types if specified.
+                     * @param message ListClarificationsRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.common.GetCurrentSessionResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.ListClarificationsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this GetCurrentSessionResponse to JSON.
+                     * Converts this ListClarificationsRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
-            }
 
-            /** Namespace contestant. */
-            namespace contestant {
+                /** Properties of a ListClarificationsResponse. */
+                interface IListClarificationsResponse {
 
-                /** Properties of a ListBenchmarkJobsRequest. */
-                interface IListBenchmarkJobsRequest {
+                    /** ListClarificationsResponse clarifications */
+                    clarifications?: (xsuportal.proto.resources.IClarification[]|null);
                 }
 
-                /** Represents a ListBenchmarkJobsRequest. */
-                class ListBenchmarkJobsRequest implements IListBenchmarkJobsRequest {
+                /** Represents a ListClarificationsResponse. */
+                class ListClarificationsResponse implements IListClarificationsResponse {
 
                     /**
-                     * Constructs a new ListBenchmarkJobsRequest.
+                     * Constructs a new ListClarificationsResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.IListClarificationsResponse);
+
+                    /** ListClarificationsResponse clarifications. */
+                    public clarifications: xsuportal.proto.resources.IClarification[];
 
                     /**
-                     * Creates a new ListBenchmarkJobsRequest instance using the specified properties.
+                     * Creates a new ListClarificationsResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListBenchmarkJobsRequest instance
+                     * @returns ListClarificationsResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IListClarificationsResponse): xsuportal.proto.services.contestant.ListClarificationsResponse;
 
                     /**
-                     * Encodes the specified ListBenchmarkJobsRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsRequest.verify|verify} messages.
-                     * @param message ListBenchmarkJobsRequest message or plain object to encode
+                     * Encodes the specified ListClarificationsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsResponse.verify|verify} messages.
+                     * @param message ListClarificationsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IListClarificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListBenchmarkJobsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsRequest.verify|verify} messages.
-                     * @param message ListBenchmarkJobsRequest message or plain object to encode
+                     * Encodes the specified ListClarificationsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsResponse.verify|verify} messages.
+                     * @param message ListClarificationsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListBenchmarkJobsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListClarificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListBenchmarkJobsRequest message from the specified reader or buffer.
+                     * Decodes a ListClarificationsResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListBenchmarkJobsRequest
+                     * @returns ListClarificationsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListClarificationsResponse;
 
                     /**
-                     * Decodes a ListBenchmarkJobsRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a ListClarificationsResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListBenchmarkJobsRequest
+                     * @returns ListClarificationsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListClarificationsResponse;
 
                     /**
-                     * Verifies a ListBenchmarkJobsRequest message.
+                     * Verifies a ListClarificationsResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListBenchmarkJobsRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListClarificationsResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListBenchmarkJobsRequest
+                     * @returns ListClarificationsResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListBenchmarkJobsRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListClarificationsResponse;
 
                     /**
-                     * Creates a plain object from a ListBenchmarkJobsRequest message. Also converts values to other types if specified.
-                     * @param message ListBenchmarkJobsRequest
+                     * Creates a plain object from a ListClarificationsResponse message. Also converts values to other types if specified.
+                     * @param message ListClarificationsResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListBenchmarkJobsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.ListClarificationsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListBenchmarkJobsRequest to JSON.
+                     * Converts this ListClarificationsResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListBenchmarkJobsResponse. */
-                interface IListBenchmarkJobsResponse {
+                /** Properties of a RequestClarificationRequest. */
+                interface IRequestClarificationRequest {
 
-                    /** ListBenchmarkJobsResponse jobs */
-                    jobs?: (xsuportal.proto.resources.IBenchmarkJob[]|null);
+                    /** RequestClarificationRequest question */
+                    question?: (string|null);
                 }
 
-                /** Represents a ListBenchmarkJobsResponse. */
-                class ListBenchmarkJobsResponse implements IListBenchmarkJobsResponse {
+                /** Represents a RequestClarificationRequest. */
+                class RequestClarificationRequest implements IRequestClarificationRequest {
 
                     /**
-                     * Constructs a new ListBenchmarkJobsResponse.
+                     * Constructs a new RequestClarificationRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IRequestClarificationRequest);
 
-                    /** ListBenchmarkJobsResponse jobs. */
-                    public jobs: xsuportal.proto.resources.IBenchmarkJob[];
+                    /** RequestClarificationRequest question. */
+                    public question: string;
 
                     /**
-                     * Creates a new ListBenchmarkJobsResponse instance using the specified properties.
+                     * Creates a new RequestClarificationRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListBenchmarkJobsResponse instance
+                     * @returns RequestClarificationRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IRequestClarificationRequest): xsuportal.proto.services.contestant.RequestClarificationRequest;
 
                     /**
-                     * Encodes the specified ListBenchmarkJobsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsResponse.verify|verify} messages.
-                     * @param message ListBenchmarkJobsResponse message or plain object to encode
+                     * Encodes the specified RequestClarificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationRequest.verify|verify} messages.
+                     * @param message RequestClarificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IRequestClarificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListBenchmarkJobsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListBenchmarkJobsResponse.verify|verify} messages.
-                     * @param message ListBenchmarkJobsResponse message or plain object to encode
+                     * Encodes the specified RequestClarificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationRequest.verify|verify} messages.
+                     * @param message RequestClarificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListBenchmarkJobsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IRequestClarificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListBenchmarkJobsResponse message from the specified reader or buffer.
+                     * Decodes a RequestClarificationRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListBenchmarkJobsResponse
+                     * @returns RequestClarificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.RequestClarificationRequest;
 
                     /**
-                     * Decodes a ListBenchmarkJobsResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a RequestClarificationRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListBenchmarkJobsResponse
+                     * @returns RequestClarificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.RequestClarificationRequest;
 
                     /**
-                     * Verifies a ListBenchmarkJobsResponse message.
+                     * Verifies a RequestClarificationRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListBenchmarkJobsResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a RequestClarificationRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListBenchmarkJobsResponse
+                     * @returns RequestClarificationRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListBenchmarkJobsResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.RequestClarificationRequest;
 
                     /**
-                     * Creates a plain object from a ListBenchmarkJobsResponse message. Also converts values to other types if specified.
-                     * @param message ListBenchmarkJobsResponse
+                     * Creates a plain object from a RequestClarificationRequest message. Also converts values to other types if specified.
+                     * @param message RequestClarificationRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListBenchmarkJobsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.RequestClarificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListBenchmarkJobsResponse to JSON.
+                     * Converts this RequestClarificationRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an EnqueueBenchmarkJobRequest. */
-                interface IEnqueueBenchmarkJobRequest {
+                /** Properties of a RequestClarificationResponse. */
+                interface IRequestClarificationResponse {
 
-                    /** EnqueueBenchmarkJobRequest targetHostname */
-                    targetHostname?: (string|null);
+                    /** RequestClarificationResponse clarification */
+                    clarification?: (xsuportal.proto.resources.IClarification|null);
                 }
 
-                /** Represents an EnqueueBenchmarkJobRequest. */
-                class EnqueueBenchmarkJobRequest implements IEnqueueBenchmarkJobRequest {
+                /** Represents a RequestClarificationResponse. */
+                class RequestClarificationResponse implements IRequestClarificationResponse {
 
                     /**
-                     * Constructs a new EnqueueBenchmarkJobRequest.
+                     * Constructs a new RequestClarificationResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.IRequestClarificationResponse);
 
-                    /** EnqueueBenchmarkJobRequest targetHostname. */
-                    public targetHostname: string;
+                    /** RequestClarificationResponse clarification. */
+                    public clarification?: (xsuportal.proto.resources.IClarification|null);
 
                     /**
-                     * Creates a new EnqueueBenchmarkJobRequest instance using the specified properties.
+                     * Creates a new RequestClarificationResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns EnqueueBenchmarkJobRequest instance
+                     * @returns RequestClarificationResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IRequestClarificationResponse): xsuportal.proto.services.contestant.RequestClarificationResponse;
 
                     /**
-                     * Encodes the specified EnqueueBenchmarkJobRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest.verify|verify} messages.
-                     * @param message EnqueueBenchmarkJobRequest message or plain object to encode
+                     * Encodes the specified RequestClarificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationResponse.verify|verify} messages.
+                     * @param message RequestClarificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IRequestClarificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified EnqueueBenchmarkJobRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest.verify|verify} messages.
-                     * @param message EnqueueBenchmarkJobRequest message or plain object to encode
+                     * Encodes the specified RequestClarificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationResponse.verify|verify} messages.
+                     * @param message RequestClarificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IRequestClarificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an EnqueueBenchmarkJobRequest message from the specified reader or buffer.
+                     * Decodes a RequestClarificationResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns EnqueueBenchmarkJobRequest
+                     * @returns RequestClarificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.RequestClarificationResponse;
 
                     /**
-                     * Decodes an EnqueueBenchmarkJobRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a RequestClarificationResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns EnqueueBenchmarkJobRequest
+                     * @returns RequestClarificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.RequestClarificationResponse;
 
                     /**
-                     * Verifies an EnqueueBenchmarkJobRequest message.
+                     * Verifies a RequestClarificationResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an EnqueueBenchmarkJobRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a RequestClarificationResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns EnqueueBenchmarkJobRequest
+                     * @returns RequestClarificationResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.RequestClarificationResponse;
 
                     /**
-                     * Creates a plain object from an EnqueueBenchmarkJobRequest message. Also converts values to other types if specified.
-                     * @param message EnqueueBenchmarkJobRequest
+                     * Creates a plain object from a RequestClarificationResponse message. Also converts values to other types if specified.
+                     * @param message RequestClarificationResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.EnqueueBenchmarkJobRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.RequestClarificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this EnqueueBenchmarkJobRequest to JSON.
+                     * Converts this RequestClarificationResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an EnqueueBenchmarkJobResponse. */
-                interface IEnqueueBenchmarkJobResponse {
-
-                    /** EnqueueBenchmarkJobResponse job */
-                    job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+                /** Properties of a DashboardRequest. */
+                interface IDashboardRequest {
                 }
 
-                /** Represents an EnqueueBenchmarkJobResponse. */
-                class EnqueueBenchmarkJobResponse implements IEnqueueBenchmarkJobResponse {
+                /** Represents a DashboardRequest. */
+                class DashboardRequest implements IDashboardRequest {
 
                     /**
-                     * Constructs a new EnqueueBenchmarkJobResponse.
+                     * Constructs a new DashboardRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse);
-
-                    /** EnqueueBenchmarkJobResponse job. */
-                    public job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+                    constructor(properties?: xsuportal.proto.services.contestant.IDashboardRequest);
 
                     /**
-                     * Creates a new EnqueueBenchmarkJobResponse instance using the specified properties.
+                     * Creates a new DashboardRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns EnqueueBenchmarkJobResponse instance
+                     * @returns DashboardRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IDashboardRequest): xsuportal.proto.services.contestant.DashboardRequest;
 
                     /**
-                     * Encodes the specified EnqueueBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse.verify|verify} messages.
-                     * @param message EnqueueBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified EnqueueBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse.verify|verify} messages.
-                     * @param message EnqueueBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardRequest.verify|verify} messages.
+                     * @param message DashboardRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IEnqueueBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an EnqueueBenchmarkJobResponse message from the specified reader or buffer.
+                     * Decodes a DashboardRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns EnqueueBenchmarkJobResponse
+                     * @returns DashboardRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.DashboardRequest;
 
                     /**
-                     * Decodes an EnqueueBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns EnqueueBenchmarkJobResponse
+                     * @returns DashboardRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.DashboardRequest;
 
                     /**
-                     * Verifies an EnqueueBenchmarkJobResponse message.
+                     * Verifies a DashboardRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an EnqueueBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns EnqueueBenchmarkJobResponse
+                     * @returns DashboardRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.DashboardRequest;
 
                     /**
-                     * Creates a plain object from an EnqueueBenchmarkJobResponse message. Also converts values to other types if specified.
-                     * @param message EnqueueBenchmarkJobResponse
+                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
+                     * @param message DashboardRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.EnqueueBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this EnqueueBenchmarkJobResponse to JSON.
+                     * Converts this DashboardRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a GetBenchmarkJobQuery. */
-                interface IGetBenchmarkJobQuery {
+                /** Properties of a DashboardResponse. */
+                interface IDashboardResponse {
 
-                    /** GetBenchmarkJobQuery id */
-                    id?: (number|Long|null);
+                    /** DashboardResponse leaderboard */
+                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
                 }
 
-                /** Represents a GetBenchmarkJobQuery. */
-                class GetBenchmarkJobQuery implements IGetBenchmarkJobQuery {
+                /** Represents a DashboardResponse. */
+                class DashboardResponse implements IDashboardResponse {
 
                     /**
-                     * Constructs a new GetBenchmarkJobQuery.
+                     * Constructs a new DashboardResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery);
+                    constructor(properties?: xsuportal.proto.services.contestant.IDashboardResponse);
 
-                    /** GetBenchmarkJobQuery id. */
-                    public id: (number|Long);
+                    /** DashboardResponse leaderboard. */
+                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
 
                     /**
-                     * Creates a new GetBenchmarkJobQuery instance using the specified properties.
+                     * Creates a new DashboardResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns GetBenchmarkJobQuery instance
+                     * @returns DashboardResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+                    public static create(properties?: xsuportal.proto.services.contestant.IDashboardResponse): xsuportal.proto.services.contestant.DashboardResponse;
 
                     /**
-                     * Encodes the specified GetBenchmarkJobQuery message. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobQuery.verify|verify} messages.
-                     * @param message GetBenchmarkJobQuery message or plain object to encode
+                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified GetBenchmarkJobQuery message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobQuery.verify|verify} messages.
-                     * @param message GetBenchmarkJobQuery message or plain object to encode
+                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardResponse.verify|verify} messages.
+                     * @param message DashboardResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetBenchmarkJobQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a GetBenchmarkJobQuery message from the specified reader or buffer.
+                     * Decodes a DashboardResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns GetBenchmarkJobQuery
+                     * @returns DashboardResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.DashboardResponse;
 
                     /**
-                     * Decodes a GetBenchmarkJobQuery message from the specified reader or buffer, length delimited.
+                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns GetBenchmarkJobQuery
+                     * @returns DashboardResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.DashboardResponse;
 
                     /**
-                     * Verifies a GetBenchmarkJobQuery message.
+                     * Verifies a DashboardResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a GetBenchmarkJobQuery message from a plain object. Also converts values to their respective internal types.
+                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns GetBenchmarkJobQuery
+                     * @returns DashboardResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetBenchmarkJobQuery;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.DashboardResponse;
 
                     /**
-                     * Creates a plain object from a GetBenchmarkJobQuery message. Also converts values to other types if specified.
-                     * @param message GetBenchmarkJobQuery
+                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
+                     * @param message DashboardResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.GetBenchmarkJobQuery, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this GetBenchmarkJobQuery to JSON.
+                     * Converts this DashboardResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a GetBenchmarkJobResponse. */
-                interface IGetBenchmarkJobResponse {
+                /** Properties of a LoginRequest. */
+                interface ILoginRequest {
 
-                    /** GetBenchmarkJobResponse job */
-                    job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+                    /** LoginRequest contestantId */
+                    contestantId?: (string|null);
+
+                    /** LoginRequest password */
+                    password?: (string|null);
                 }
 
-                /** Represents a GetBenchmarkJobResponse. */
-                class GetBenchmarkJobResponse implements IGetBenchmarkJobResponse {
+                /** Represents a LoginRequest. */
+                class LoginRequest implements ILoginRequest {
 
                     /**
-                     * Constructs a new GetBenchmarkJobResponse.
+                     * Constructs a new LoginRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.ILoginRequest);
 
-                    /** GetBenchmarkJobResponse job. */
-                    public job?: (xsuportal.proto.resources.IBenchmarkJob|null);
+                    /** LoginRequest contestantId. */
+                    public contestantId: string;
+
+                    /** LoginRequest password. */
+                    public password: string;
 
                     /**
-                     * Creates a new GetBenchmarkJobResponse instance using the specified properties.
+                     * Creates a new LoginRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns GetBenchmarkJobResponse instance
+                     * @returns LoginRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.ILoginRequest): xsuportal.proto.services.contestant.LoginRequest;
 
                     /**
-                     * Encodes the specified GetBenchmarkJobResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobResponse.verify|verify} messages.
-                     * @param message GetBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified LoginRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.LoginRequest.verify|verify} messages.
+                     * @param message LoginRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ILoginRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified GetBenchmarkJobResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetBenchmarkJobResponse.verify|verify} messages.
-                     * @param message GetBenchmarkJobResponse message or plain object to encode
+                     * Encodes the specified LoginRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LoginRequest.verify|verify} messages.
+                     * @param message LoginRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetBenchmarkJobResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILoginRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a GetBenchmarkJobResponse message from the specified reader or buffer.
+                     * Decodes a LoginRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns GetBenchmarkJobResponse
+                     * @returns LoginRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LoginRequest;
 
                     /**
-                     * Decodes a GetBenchmarkJobResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a LoginRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns GetBenchmarkJobResponse
+                     * @returns LoginRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LoginRequest;
 
                     /**
-                     * Verifies a GetBenchmarkJobResponse message.
+                     * Verifies a LoginRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a GetBenchmarkJobResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a LoginRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns GetBenchmarkJobResponse
+                     * @returns LoginRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetBenchmarkJobResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LoginRequest;
 
                     /**
-                     * Creates a plain object from a GetBenchmarkJobResponse message. Also converts values to other types if specified.
-                     * @param message GetBenchmarkJobResponse
+                     * Creates a plain object from a LoginRequest message. Also converts values to other types if specified.
+                     * @param message LoginRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.GetBenchmarkJobResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.LoginRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this GetBenchmarkJobResponse to JSON.
+                     * Converts this LoginRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListClarificationsRequest. */
-                interface IListClarificationsRequest {
+                /** Properties of a LoginResponse. */
+                interface ILoginResponse {
                 }
 
-                /** Represents a ListClarificationsRequest. */
-                class ListClarificationsRequest implements IListClarificationsRequest {
+                /** Represents a LoginResponse. */
+                class LoginResponse implements ILoginResponse {
 
                     /**
-                     * Constructs a new ListClarificationsRequest.
+                     * Constructs a new LoginResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListClarificationsRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.ILoginResponse);
 
                     /**
-                     * Creates a new ListClarificationsRequest instance using the specified properties.
+                     * Creates a new LoginResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListClarificationsRequest instance
+                     * @returns LoginResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListClarificationsRequest): xsuportal.proto.services.contestant.ListClarificationsRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.ILoginResponse): xsuportal.proto.services.contestant.LoginResponse;
 
                     /**
-                     * Encodes the specified ListClarificationsRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsRequest.verify|verify} messages.
-                     * @param message ListClarificationsRequest message or plain object to encode
+                     * Encodes the specified LoginResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.LoginResponse.verify|verify} messages.
+                     * @param message LoginResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListClarificationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ILoginResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListClarificationsRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsRequest.verify|verify} messages.
-                     * @param message ListClarificationsRequest message or plain object to encode
+                     * Encodes the specified LoginResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LoginResponse.verify|verify} messages.
+                     * @param message LoginResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListClarificationsRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILoginResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListClarificationsRequest message from the specified reader or buffer.
+                     * Decodes a LoginResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListClarificationsRequest
+                     * @returns LoginResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListClarificationsRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LoginResponse;
 
                     /**
-                     * Decodes a ListClarificationsRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a LoginResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListClarificationsRequest
+                     * @returns LoginResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListClarificationsRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LoginResponse;
 
                     /**
-                     * Verifies a ListClarificationsRequest message.
+                     * Verifies a LoginResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListClarificationsRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a LoginResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListClarificationsRequest
+                     * @returns LoginResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListClarificationsRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LoginResponse;
 
                     /**
-                     * Creates a plain object from a ListClarificationsRequest message. Also converts values to other types if specified.
-                     * @param message ListClarificationsRequest
+                     * Creates a plain object from a LoginResponse message. Also converts values to other types if specified.
+                     * @param message LoginResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListClarificationsRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.LoginResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListClarificationsRequest to JSON.
+                     * Converts this LoginResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListClarificationsResponse. */
-                interface IListClarificationsResponse {
-
-                    /** ListClarificationsResponse clarifications */
-                    clarifications?: (xsuportal.proto.resources.IClarification[]|null);
+                /** Properties of a LogoutRequest. */
+                interface ILogoutRequest {
                 }
 
-                /** Represents a ListClarificationsResponse. */
-                class ListClarificationsResponse implements IListClarificationsResponse {
+                /** Represents a LogoutRequest. */
+                class LogoutRequest implements ILogoutRequest {
 
                     /**
-                     * Constructs a new ListClarificationsResponse.
-                     * @param [properties] Properties to set
-                     */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListClarificationsResponse);
-
-                    /** ListClarificationsResponse clarifications. */
-                    public clarifications: xsuportal.proto.resources.IClarification[];
+                     * Constructs a new LogoutRequest.
+                     * @param [properties] Properties to set
+                     */
+                    constructor(properties?: xsuportal.proto.services.contestant.ILogoutRequest);
 
                     /**
-                     * Creates a new ListClarificationsResponse instance using the specified properties.
+                     * Creates a new LogoutRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListClarificationsResponse instance
+                     * @returns LogoutRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListClarificationsResponse): xsuportal.proto.services.contestant.ListClarificationsResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.ILogoutRequest): xsuportal.proto.services.contestant.LogoutRequest;
 
                     /**
-                     * Encodes the specified ListClarificationsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsResponse.verify|verify} messages.
-                     * @param message ListClarificationsResponse message or plain object to encode
+                     * Encodes the specified LogoutRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutRequest.verify|verify} messages.
+                     * @param message LogoutRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListClarificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ILogoutRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListClarificationsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListClarificationsResponse.verify|verify} messages.
-                     * @param message ListClarificationsResponse message or plain object to encode
+                     * Encodes the specified LogoutRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutRequest.verify|verify} messages.
+                     * @param message LogoutRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListClarificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILogoutRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListClarificationsResponse message from the specified reader or buffer.
+                     * Decodes a LogoutRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListClarificationsResponse
+                     * @returns LogoutRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListClarificationsResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LogoutRequest;
 
                     /**
-                     * Decodes a ListClarificationsResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a LogoutRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListClarificationsResponse
+                     * @returns LogoutRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListClarificationsResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LogoutRequest;
 
                     /**
-                     * Verifies a ListClarificationsResponse message.
+                     * Verifies a LogoutRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListClarificationsResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a LogoutRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListClarificationsResponse
+                     * @returns LogoutRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListClarificationsResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LogoutRequest;
 
                     /**
-                     * Creates a plain object from a ListClarificationsResponse message. Also converts values to other types if specified.
-                     * @param message ListClarificationsResponse
+                     * Creates a plain object from a LogoutRequest message. Also converts values to other types if specified.
+                     * @param message LogoutRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListClarificationsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.LogoutRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListClarificationsResponse to JSON.
+                     * Converts this LogoutRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a RequestClarificationRequest. */
-                interface IRequestClarificationRequest {
-
-                    /** RequestClarificationRequest question */
-                    question?: (string|null);
+                /** Properties of a LogoutResponse. */
+                interface ILogoutResponse {
                 }
 
-                /** Represents a RequestClarificationRequest. */
-                class RequestClarificationRequest implements IRequestClarificationRequest {
+                /** Represents a LogoutResponse. */
+                class LogoutResponse implements ILogoutResponse {
 
                     /**
-                     * Constructs a new RequestClarificationRequest.
+                     * Constructs a new LogoutResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IRequestClarificationRequest);
-
-                    /** RequestClarificationRequest question. */
-                    public question: string;
+                    constructor(properties?: xsuportal.proto.services.contestant.ILogoutResponse);
 
                     /**
-                     * Creates a new RequestClarificationRequest instance using the specified properties.
+                     * Creates a new LogoutResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns RequestClarificationRequest instance
+                     * @returns LogoutResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IRequestClarificationRequest): xsuportal.proto.services.contestant.RequestClarificationRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.ILogoutResponse): xsuportal.proto.services.contestant.LogoutResponse;
 
                     /**
-                     * Encodes the specified RequestClarificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationRequest.verify|verify} messages.
-                     * @param message RequestClarificationRequest message or plain object to encode
+                     * Encodes the specified LogoutResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutResponse.verify|verify} messages.
+                     * @param message LogoutResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IRequestClarificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ILogoutResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified RequestClarificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationRequest.verify|verify} messages.
-                     * @param message RequestClarificationRequest message or plain object to encode
+                     * Encodes the specified LogoutResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutResponse.verify|verify} messages.
+                     * @param message LogoutResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IRequestClarificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILogoutResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a RequestClarificationRequest message from the specified reader or buffer.
+                     * Decodes a LogoutResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns RequestClarificationRequest
+                     * @returns LogoutResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.RequestClarificationRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LogoutResponse;
 
                     /**
-                     * Decodes a RequestClarificationRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a LogoutResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns RequestClarificationRequest
+                     * @returns LogoutResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.RequestClarificationRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LogoutResponse;
 
                     /**
-                     * Verifies a RequestClarificationRequest message.
+                     * Verifies a LogoutResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a RequestClarificationRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a LogoutResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns RequestClarificationRequest
+                     * @returns LogoutResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.RequestClarificationRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LogoutResponse;
 
                     /**
-                     * Creates a plain object from a RequestClarificationRequest message. Also converts values to other types if specified.
-                     * @param message RequestClarificationRequest
+                     * Creates a plain object from a LogoutResponse message. Also converts values to other types if specified.
+                     * @param message LogoutResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.RequestClarificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.LogoutResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this RequestClarificationRequest to JSON.
+                     * Converts this LogoutResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a RequestClarificationResponse. */
-                interface IRequestClarificationResponse {
+                /** Properties of a ListNotificationsQuery. */
+                interface IListNotificationsQuery {
 
-                    /** RequestClarificationResponse clarification */
-                    clarification?: (xsuportal.proto.resources.IClarification|null);
+                    /** ListNotificationsQuery after */
+                    after?: (number|Long|null);
                 }
 
-                /** Represents a RequestClarificationResponse. */
-                class RequestClarificationResponse implements IRequestClarificationResponse {
+                /** Represents a ListNotificationsQuery. */
+                class ListNotificationsQuery implements IListNotificationsQuery {
 
                     /**
-                     * Constructs a new RequestClarificationResponse.
+                     * Constructs a new ListNotificationsQuery.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IRequestClarificationResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IListNotificationsQuery);
 
-                    /** RequestClarificationResponse clarification. */
-                    public clarification?: (xsuportal.proto.resources.IClarification|null);
+                    /** ListNotificationsQuery after. */
+                    public after: (number|Long);
 
                     /**
-                     * Creates a new RequestClarificationResponse instance using the specified properties.
+                     * Creates a new ListNotificationsQuery instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns RequestClarificationResponse instance
+                     * @returns ListNotificationsQuery instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IRequestClarificationResponse): xsuportal.proto.services.contestant.RequestClarificationResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IListNotificationsQuery): xsuportal.proto.services.contestant.ListNotificationsQuery;
 
                     /**
-                     * Encodes the specified RequestClarificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationResponse.verify|verify} messages.
-                     * @param message RequestClarificationResponse message or plain object to encode
+                     * Encodes the specified ListNotificationsQuery message. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsQuery.verify|verify} messages.
+                     * @param message ListNotificationsQuery message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IRequestClarificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IListNotificationsQuery, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified RequestClarificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.RequestClarificationResponse.verify|verify} messages.
-                     * @param message RequestClarificationResponse message or plain object to encode
+                     * Encodes the specified ListNotificationsQuery message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsQuery.verify|verify} messages.
+                     * @param message ListNotificationsQuery message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IRequestClarificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListNotificationsQuery, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a RequestClarificationResponse message from the specified reader or buffer.
+                     * Decodes a ListNotificationsQuery message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns RequestClarificationResponse
+                     * @returns ListNotificationsQuery
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.RequestClarificationResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListNotificationsQuery;
 
                     /**
-                     * Decodes a RequestClarificationResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a ListNotificationsQuery message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns RequestClarificationResponse
+                     * @returns ListNotificationsQuery
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.RequestClarificationResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListNotificationsQuery;
 
                     /**
-                     * Verifies a RequestClarificationResponse message.
+                     * Verifies a ListNotificationsQuery message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a RequestClarificationResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListNotificationsQuery message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns RequestClarificationResponse
+                     * @returns ListNotificationsQuery
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.RequestClarificationResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListNotificationsQuery;
 
                     /**
-                     * Creates a plain object from a RequestClarificationResponse message. Also converts values to other types if specified.
-                     * @param message RequestClarificationResponse
+                     * Creates a plain object from a ListNotificationsQuery message. Also converts values to other types if specified.
+                     * @param message ListNotificationsQuery
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.RequestClarificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.ListNotificationsQuery, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this RequestClarificationResponse to JSON.
+                     * Converts this ListNotificationsQuery to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a DashboardRequest. */
-                interface IDashboardRequest {
+                /** Properties of a ListNotificationsResponse. */
+                interface IListNotificationsResponse {
+
+                    /** ListNotificationsResponse lastAnsweredClarificationId */
+                    lastAnsweredClarificationId?: (number|Long|null);
+
+                    /** ListNotificationsResponse notifications */
+                    notifications?: (xsuportal.proto.resources.INotification[]|null);
+
+                    /** ListNotificationsResponse unreadCount */
+                    unreadCount?: (number|Long|null);
                 }
 
-                /** Represents a DashboardRequest. */
-                class DashboardRequest implements IDashboardRequest {
+                /** Represents a ListNotificationsResponse. */
+                class ListNotificationsResponse implements IListNotificationsResponse {
 
                     /**
-                     * Constructs a new DashboardRequest.
+                     * Constructs a new ListNotificationsResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IDashboardRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.IListNotificationsResponse);
+
+                    /** ListNotificationsResponse lastAnsweredClarificationId. */
+                    public lastAnsweredClarificationId: (number|Long);
+
+                    /** ListNotificationsResponse notifications. */
+                    public notifications: xsuportal.proto.resources.INotification[];
+
+                    /** ListNotificationsResponse unreadCount. */
+                    public unreadCount: (number|Long);
 
                     /**
-                     * Creates a new DashboardRequest instance using the specified properties.
+                     * Creates a new ListNotificationsResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardRequest instance
+                     * @returns ListNotificationsResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IDashboardRequest): xsuportal.proto.services.contestant.DashboardRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IListNotificationsResponse): xsuportal.proto.services.contestant.ListNotificationsResponse;
 
                     /**
-                     * Encodes the specified DashboardRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified ListNotificationsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsResponse.verify|verify} messages.
+                     * @param message ListNotificationsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IListNotificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardRequest.verify|verify} messages.
-                     * @param message DashboardRequest message or plain object to encode
+                     * Encodes the specified ListNotificationsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsResponse.verify|verify} messages.
+                     * @param message ListNotificationsResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IDashboardRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListNotificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer.
+                     * Decodes a ListNotificationsResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardRequest
+                     * @returns ListNotificationsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.DashboardRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListNotificationsResponse;
 
                     /**
-                     * Decodes a DashboardRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a ListNotificationsResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardRequest
+                     * @returns ListNotificationsResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.DashboardRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListNotificationsResponse;
 
                     /**
-                     * Verifies a DashboardRequest message.
+                     * Verifies a ListNotificationsResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a ListNotificationsResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardRequest
+                     * @returns ListNotificationsResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.DashboardRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListNotificationsResponse;
 
                     /**
-                     * Creates a plain object from a DashboardRequest message. Also converts values to other types if specified.
-                     * @param message DashboardRequest
+                     * Creates a plain object from a ListNotificationsResponse message. Also converts values to other types if specified.
+                     * @param message ListNotificationsResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.DashboardRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.ListNotificationsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardRequest to JSON.
+                     * Converts this ListNotificationsResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a DashboardResponse. */
-                interface IDashboardResponse {
+                /** Properties of a MarkNotificationsReadRequest. */
+                interface IMarkNotificationsReadRequest {
 
-                    /** DashboardResponse leaderboard */
-                    leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** MarkNotificationsReadRequest ids */
+                    ids?: ((number|Long)[]|null);
+
+                    /** MarkNotificationsReadRequest upTo */
+                    upTo?: (number|Long|null);
                 }
 
-                /** Represents a DashboardResponse. */
-                class DashboardResponse implements IDashboardResponse {
+                /** Represents a MarkNotificationsReadRequest. */
+                class MarkNotificationsReadRequest implements IMarkNotificationsReadRequest {
 
                     /**
-                     * Constructs a new DashboardResponse.
+                     * Constructs a new MarkNotificationsReadRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IDashboardResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IMarkNotificationsReadRequest);
 
-                    /** DashboardResponse leaderboard. */
-                    public leaderboard?: (xsuportal.proto.resources.ILeaderboard|null);
+                    /** MarkNotificationsReadRequest ids. */
+                    public ids: (number|Long)[];
+
+                    /** MarkNotificationsReadRequest upTo. */
+                    public upTo: (number|Long);
 
                     /**
-                     * Creates a new DashboardResponse instance using the specified properties.
+                     * Creates a new MarkNotificationsReadRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns DashboardResponse instance
+                     * @returns MarkNotificationsReadRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IDashboardResponse): xsuportal.proto.services.contestant.DashboardResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IMarkNotificationsReadRequest): xsuportal.proto.services.contestant.MarkNotificationsReadRequest;
 
                     /**
-                     * Encodes the specified DashboardResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified MarkNotificationsReadRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.MarkNotificationsReadRequest.verify|verify} messages.
+                     * @param message MarkNotificationsReadRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IMarkNotificationsReadRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified DashboardResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.DashboardResponse.verify|verify} messages.
-                     * @param message DashboardResponse message or plain object to encode
+                     * Encodes the specified MarkNotificationsReadRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.MarkNotificationsReadRequest.verify|verify} messages.
+                     * @param message MarkNotificationsReadRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IDashboardResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IMarkNotificationsReadRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer.
+                     * Decodes a MarkNotificationsReadRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns DashboardResponse
+                     * @returns MarkNotificationsReadRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.DashboardResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.MarkNotificationsReadRequest;
 
                     /**
-                     * Decodes a DashboardResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a MarkNotificationsReadRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns DashboardResponse
+                     * @returns MarkNotificationsReadRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.DashboardResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.MarkNotificationsReadRequest;
 
                     /**
-                     * Verifies a DashboardResponse message.
+                     * Verifies a MarkNotificationsReadRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a DashboardResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a MarkNotificationsReadRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns DashboardResponse
+                     * @returns MarkNotificationsReadRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.DashboardResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.MarkNotificationsReadRequest;
 
                     /**
-                     * Creates a plain object from a DashboardResponse message. Also converts values to other types if specified.
-                     * @param message DashboardResponse
+                     * Creates a plain object from a MarkNotificationsReadRequest message. Also converts values to other types if specified.
+                     * @param message MarkNotificationsReadRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.DashboardResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.MarkNotificationsReadRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this DashboardResponse to JSON.
+                     * Converts this MarkNotificationsReadRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a LoginRequest. */
-                interface ILoginRequest {
-
-                    /** LoginRequest contestantId */
-                    contestantId?: (string|null);
+                /** Properties of a MarkNotificationsReadResponse. */
+                interface IMarkNotificationsReadResponse {
 
-                    /** LoginRequest password */
-                    password?: (string|null);
+                    /** MarkNotificationsReadResponse unreadCount */
+                    unreadCount?: (number|Long|null);
                 }
 
-                /** Represents a LoginRequest. */
-                class LoginRequest implements ILoginRequest {
+                /** Represents a MarkNotificationsReadResponse. */
+                class MarkNotificationsReadResponse implements IMarkNotificationsReadResponse {
 
                     /**
-                     * Constructs a new LoginRequest.
+                     * Constructs a new MarkNotificationsReadResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ILoginRequest);
-
-                    /** LoginRequest contestantId. */
-                    public contestantId: string;
+                    constructor(properties?: xsuportal.proto.services.contestant.IMarkNotificationsReadResponse);
 
-                    /** LoginRequest password. */
-                    public password: string;
+                    /** MarkNotificationsReadResponse unreadCount. */
+                    public unreadCount: (number|Long);
 
                     /**
-                     * Creates a new LoginRequest instance using the specified properties.
+                     * Creates a new MarkNotificationsReadResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns LoginRequest instance
+                     * @returns MarkNotificationsReadResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ILoginRequest): xsuportal.proto.services.contestant.LoginRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IMarkNotificationsReadResponse): xsuportal.proto.services.contestant.MarkNotificationsReadResponse;
 
                     /**
-                     * Encodes the specified LoginRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.LoginRequest.verify|verify} messages.
-                     * @param message LoginRequest message or plain object to encode
+                     * Encodes the specified MarkNotificationsReadResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.MarkNotificationsReadResponse.verify|verify} messages.
+                     * @param message MarkNotificationsReadResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ILoginRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IMarkNotificationsReadResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified LoginRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LoginRequest.verify|verify} messages.
-                     * @param message LoginRequest message or plain object to encode
+                     * Encodes the specified MarkNotificationsReadResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.MarkNotificationsReadResponse.verify|verify} messages.
+                     * @param message MarkNotificationsReadResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILoginRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IMarkNotificationsReadResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a LoginRequest message from the specified reader or buffer.
+                     * Decodes a MarkNotificationsReadResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns LoginRequest
+                     * @returns MarkNotificationsReadResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LoginRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.MarkNotificationsReadResponse;
 
                     /**
-                     * Decodes a LoginRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a MarkNotificationsReadResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns LoginRequest
+                     * @returns MarkNotificationsReadResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LoginRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.MarkNotificationsReadResponse;
 
                     /**
-                     * Verifies a LoginRequest message.
+                     * Verifies a MarkNotificationsReadResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a LoginRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a MarkNotificationsReadResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns LoginRequest
+                     * @returns MarkNotificationsReadResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LoginRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.MarkNotificationsReadResponse;
 
                     /**
-                     * Creates a plain object from a LoginRequest message. Also converts values to other types if specified.
-                     * @param message LoginRequest
+                     * Creates a plain object from a MarkNotificationsReadResponse message. Also converts values to other types if specified.
+                     * @param message MarkNotificationsReadResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.LoginRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.MarkNotificationsReadResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this LoginRequest to JSON.
+                     * Converts this MarkNotificationsReadResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a LoginResponse. */
-                interface ILoginResponse {
+                /** Properties of a SubscribeNotificationRequest. */
+                interface ISubscribeNotificationRequest {
+
+                    /** SubscribeNotificationRequest endpoint */
+                    endpoint?: (string|null);
+
+                    /** SubscribeNotificationRequest p256dh */
+                    p256dh?: (string|null);
+
+                    /** SubscribeNotificationRequest auth */
+                    auth?: (string|null);
                 }
 
-                /** Represents a LoginResponse. */
-                class LoginResponse implements ILoginResponse {
+                /** Represents a SubscribeNotificationRequest. */
+                class SubscribeNotificationRequest implements ISubscribeNotificationRequest {
 
                     /**
-                     * Constructs a new LoginResponse.
+                     * Constructs a new SubscribeNotificationRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ILoginResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationRequest);
+
+                    /** SubscribeNotificationRequest endpoint. */
+                    public endpoint: string;
+
+                    /** SubscribeNotificationRequest p256dh. */
+                    public p256dh: string;
+
+                    /** SubscribeNotificationRequest auth. */
+                    public auth: string;
 
                     /**
-                     * Creates a new LoginResponse instance using the specified properties.
+                     * Creates a new SubscribeNotificationRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns LoginResponse instance
+                     * @returns SubscribeNotificationRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ILoginResponse): xsuportal.proto.services.contestant.LoginResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationRequest): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
 
                     /**
-                     * Encodes the specified LoginResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.LoginResponse.verify|verify} messages.
-                     * @param message LoginResponse message or plain object to encode
+                     * Encodes the specified SubscribeNotificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationRequest.verify|verify} messages.
+                     * @param message SubscribeNotificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ILoginResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ISubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified LoginResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LoginResponse.verify|verify} messages.
-                     * @param message LoginResponse message or plain object to encode
+                     * Encodes the specified SubscribeNotificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationRequest.verify|verify} messages.
+                     * @param message SubscribeNotificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILoginResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ISubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a LoginResponse message from the specified reader or buffer.
+                     * Decodes a SubscribeNotificationRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns LoginResponse
+                     * @returns SubscribeNotificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LoginResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
 
                     /**
-                     * Decodes a LoginResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a SubscribeNotificationRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns LoginResponse
+                     * @returns SubscribeNotificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LoginResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
 
                     /**
-                     * Verifies a LoginResponse message.
+                     * Verifies a SubscribeNotificationRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a LoginResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a SubscribeNotificationRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns LoginResponse
+                     * @returns SubscribeNotificationRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LoginResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
 
                     /**
-                     * Creates a plain object from a LoginResponse message. Also converts values to other types if specified.
-                     * @param message LoginResponse
+                     * Creates a plain object from a SubscribeNotificationRequest message. Also converts values to other types if specified.
+                     * @param message SubscribeNotificationRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.LoginResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.SubscribeNotificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this LoginResponse to JSON.
+                     * Converts this SubscribeNotificationRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a LogoutRequest. */
-                interface ILogoutRequest {
+                /** Properties of a SubscribeNotificationResponse. */
+                interface ISubscribeNotificationResponse {
                 }
 
-                /** Represents a LogoutRequest. */
-                class LogoutRequest implements ILogoutRequest {
+                /** Represents a SubscribeNotificationResponse. */
+                class SubscribeNotificationResponse implements ISubscribeNotificationResponse {
 
                     /**
-                     * Constructs a new LogoutRequest.
+                     * Constructs a new SubscribeNotificationResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ILogoutRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationResponse);
 
                     /**
-                     * Creates a new LogoutRequest instance using the specified properties.
+                     * Creates a new SubscribeNotificationResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns LogoutRequest instance
+                     * @returns SubscribeNotificationResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ILogoutRequest): xsuportal.proto.services.contestant.LogoutRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationResponse): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
 
                     /**
-                     * Encodes the specified LogoutRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutRequest.verify|verify} messages.
-                     * @param message LogoutRequest message or plain object to encode
+                     * Encodes the specified SubscribeNotificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationResponse.verify|verify} messages.
+                     * @param message SubscribeNotificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ILogoutRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.ISubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified LogoutRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutRequest.verify|verify} messages.
-                     * @param message LogoutRequest message or plain object to encode
+                     * Encodes the specified SubscribeNotificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationResponse.verify|verify} messages.
+                     * @param message SubscribeNotificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILogoutRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ISubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a LogoutRequest message from the specified reader or buffer.
+                     * Decodes a SubscribeNotificationResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns LogoutRequest
+                     * @returns SubscribeNotificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LogoutRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
 
                     /**
-                     * Decodes a LogoutRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a SubscribeNotificationResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns LogoutRequest
+                     * @returns SubscribeNotificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LogoutRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
 
                     /**
-                     * Verifies a LogoutRequest message.
+                     * Verifies a SubscribeNotificationResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a LogoutRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a SubscribeNotificationResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns LogoutRequest
+                     * @returns SubscribeNotificationResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LogoutRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
 
                     /**
-                     * Creates a plain object from a LogoutRequest message. Also converts values to other types if specified.
-                     * @param message LogoutRequest
+                     * Creates a plain object from a SubscribeNotificationResponse message. Also converts values to other types if specified.
+                     * @param message SubscribeNotificationResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.LogoutRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.SubscribeNotificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this LogoutRequest to JSON.
+                     * Converts this SubscribeNotificationResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a LogoutResponse. */
-                interface ILogoutResponse {
+                /** Properties of an UnsubscribeNotificationRequest. */
+                interface IUnsubscribeNotificationRequest {
+
+                    /** UnsubscribeNotificationRequest endpoint */
+                    endpoint?: (string|null);
                 }
 
-                /** Represents a LogoutResponse. */
-                class LogoutResponse implements ILogoutResponse {
+                /** Represents an UnsubscribeNotificationRequest. */
+                class UnsubscribeNotificationRequest implements IUnsubscribeNotificationRequest {
 
                     /**
-                     * Constructs a new LogoutResponse.
+                     * Constructs a new UnsubscribeNotificationRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ILogoutResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest);
+
+                    /** UnsubscribeNotificationRequest endpoint. */
+                    public endpoint: string;
 
                     /**
-                     * Creates a new LogoutResponse instance using the specified properties.
+                     * Creates a new UnsubscribeNotificationRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns LogoutResponse instance
+                     * @returns UnsubscribeNotificationRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ILogoutResponse): xsuportal.proto.services.contestant.LogoutResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
 
                     /**
-                     * Encodes the specified LogoutResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutResponse.verify|verify} messages.
-                     * @param message LogoutResponse message or plain object to encode
+                     * Encodes the specified UnsubscribeNotificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationRequest.verify|verify} messages.
+                     * @param message UnsubscribeNotificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ILogoutResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified LogoutResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.LogoutResponse.verify|verify} messages.
-                     * @param message LogoutResponse message or plain object to encode
+                     * Encodes the specified UnsubscribeNotificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationRequest.verify|verify} messages.
+                     * @param message UnsubscribeNotificationRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ILogoutResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a LogoutResponse message from the specified reader or buffer.
+                     * Decodes an UnsubscribeNotificationRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns LogoutResponse
+                     * @returns UnsubscribeNotificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.LogoutResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
 
                     /**
-                     * Decodes a LogoutResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an UnsubscribeNotificationRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns LogoutResponse
+                     * @returns UnsubscribeNotificationRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.LogoutResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
 
                     /**
-                     * Verifies a LogoutResponse message.
+                     * Verifies an UnsubscribeNotificationRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a LogoutResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UnsubscribeNotificationRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns LogoutResponse
+                     * @returns UnsubscribeNotificationRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.LogoutResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
 
                     /**
-                     * Creates a plain object from a LogoutResponse message. Also converts values to other types if specified.
-                     * @param message LogoutResponse
+                     * Creates a plain object from an UnsubscribeNotificationRequest message. Also converts values to other types if specified.
+                     * @param message UnsubscribeNotificationRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.LogoutResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.UnsubscribeNotificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this LogoutResponse to JSON.
+                     * Converts this UnsubscribeNotificationRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListNotificationsQuery. */
-                interface IListNotificationsQuery {
-
-                    /** ListNotificationsQuery after */
-                    after?: (number|Long|null);
+                /** Properties of an UnsubscribeNotificationResponse. */
+                interface IUnsubscribeNotificationResponse {
                 }
 
-                /** Represents a ListNotificationsQuery. */
-                class ListNotificationsQuery implements IListNotificationsQuery {
+                /** Represents an UnsubscribeNotificationResponse. */
+                class UnsubscribeNotificationResponse implements IUnsubscribeNotificationResponse {
 
                     /**
-                     * Constructs a new ListNotificationsQuery.
+                     * Constructs a new UnsubscribeNotificationResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListNotificationsQuery);
-
-                    /** ListNotificationsQuery after. */
-                    public after: (number|Long);
+                    constructor(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse);
 
                     /**
-                     * Creates a new ListNotificationsQuery instance using the specified properties.
+                     * Creates a new UnsubscribeNotificationResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListNotificationsQuery instance
+                     * @returns UnsubscribeNotificationResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListNotificationsQuery): xsuportal.proto.services.contestant.ListNotificationsQuery;
+                    public static create(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
 
                     /**
-                     * Encodes the specified ListNotificationsQuery message. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsQuery.verify|verify} messages.
-                     * @param message ListNotificationsQuery message or plain object to encode
+                     * Encodes the specified UnsubscribeNotificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationResponse.verify|verify} messages.
+                     * @param message UnsubscribeNotificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListNotificationsQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListNotificationsQuery message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsQuery.verify|verify} messages.
-                     * @param message ListNotificationsQuery message or plain object to encode
+                     * Encodes the specified UnsubscribeNotificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationResponse.verify|verify} messages.
+                     * @param message UnsubscribeNotificationResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListNotificationsQuery, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListNotificationsQuery message from the specified reader or buffer.
+                     * Decodes an UnsubscribeNotificationResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListNotificationsQuery
+                     * @returns UnsubscribeNotificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListNotificationsQuery;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
 
                     /**
-                     * Decodes a ListNotificationsQuery message from the specified reader or buffer, length delimited.
+                     * Decodes an UnsubscribeNotificationResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListNotificationsQuery
+                     * @returns UnsubscribeNotificationResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListNotificationsQuery;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
 
                     /**
-                     * Verifies a ListNotificationsQuery message.
+                     * Verifies an UnsubscribeNotificationResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListNotificationsQuery message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UnsubscribeNotificationResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListNotificationsQuery
+                     * @returns UnsubscribeNotificationResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListNotificationsQuery;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
 
                     /**
-                     * Creates a plain object from a ListNotificationsQuery message. Also converts values to other types if specified.
-                     * @param message ListNotificationsQuery
+                     * Creates a plain object from an UnsubscribeNotificationResponse message. Also converts values to other types if specified.
+                     * @param message UnsubscribeNotificationResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListNotificationsQuery, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.UnsubscribeNotificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListNotificationsQuery to JSON.
+                     * Converts this UnsubscribeNotificationResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a ListNotificationsResponse. */
-                interface IListNotificationsResponse {
+                /** Properties of a NotificationPreference. */
+                interface INotificationPreference {
 
-                    /** ListNotificationsResponse lastAnsweredClarificationId */
-                    lastAnsweredClarificationId?: (number|Long|null);
+                    /** NotificationPreference contentType */
+                    contentType?: (string|null);
 
-                    /** ListNotificationsResponse notifications */
-                    notifications?: (xsuportal.proto.resources.INotification[]|null);
+                    /** NotificationPreference inApp */
+                    inApp?: (boolean|null);
+
+                    /** NotificationPreference webPush */
+                    webPush?: (boolean|null);
                 }
 
-                /** Represents a ListNotificationsResponse. */
-                class ListNotificationsResponse implements IListNotificationsResponse {
+                /** Represents a NotificationPreference. */
+                class NotificationPreference implements INotificationPreference {
 
                     /**
-                     * Constructs a new ListNotificationsResponse.
+                     * Constructs a new NotificationPreference.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IListNotificationsResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.INotificationPreference);
 
-                    /** ListNotificationsResponse lastAnsweredClarificationId. */
-                    public lastAnsweredClarificationId: (number|Long);
+                    /** NotificationPreference contentType. */
+                    public contentType: string;
 
-                    /** ListNotificationsResponse notifications. */
-                    public notifications: xsuportal.proto.resources.INotification[];
+                    /** NotificationPreference inApp. */
+                    public inApp: boolean;
+
+                    /** NotificationPreference webPush. */
+                    public webPush: boolean;
 
                     /**
-                     * Creates a new ListNotificationsResponse instance using the specified properties.
+                     * Creates a new NotificationPreference instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns ListNotificationsResponse instance
+                     * @returns NotificationPreference instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IListNotificationsResponse): xsuportal.proto.services.contestant.ListNotificationsResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.INotificationPreference): xsuportal.proto.services.contestant.NotificationPreference;
 
                     /**
-                     * Encodes the specified ListNotificationsResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsResponse.verify|verify} messages.
-                     * @param message ListNotificationsResponse message or plain object to encode
+                     * Encodes the specified NotificationPreference message. Does not implicitly {@link xsuportal.proto.services.contestant.NotificationPreference.verify|verify} messages.
+                     * @param message NotificationPreference message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IListNotificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.INotificationPreference, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified ListNotificationsResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.ListNotificationsResponse.verify|verify} messages.
-                     * @param message ListNotificationsResponse message or plain object to encode
+                     * Encodes the specified NotificationPreference message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.NotificationPreference.verify|verify} messages.
+                     * @param message NotificationPreference message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IListNotificationsResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.INotificationPreference, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a ListNotificationsResponse message from the specified reader or buffer.
+                     * Decodes a NotificationPreference message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns ListNotificationsResponse
+                     * @returns NotificationPreference
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.ListNotificationsResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.NotificationPreference;
 
                     /**
-                     * Decodes a ListNotificationsResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a NotificationPreference message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns ListNotificationsResponse
+                     * @returns NotificationPreference
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.ListNotificationsResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.NotificationPreference;
 
                     /**
-                     * Verifies a ListNotificationsResponse message.
+                     * Verifies a NotificationPreference message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a ListNotificationsResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a NotificationPreference message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns ListNotificationsResponse
+                     * @returns NotificationPreference
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.ListNotificationsResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.NotificationPreference;
 
                     /**
-                     * Creates a plain object from a ListNotificationsResponse message. Also converts values to other types if specified.
-                     * @param message ListNotificationsResponse
+                     * Creates a plain object from a NotificationPreference message. Also converts values to other types if specified.
+                     * @param message NotificationPreference
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.ListNotificationsResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.NotificationPreference, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this ListNotificationsResponse to JSON.
+                     * Converts this NotificationPreference to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a SubscribeNotificationRequest. */
-                interface ISubscribeNotificationRequest {
-
-                    /** SubscribeNotificationRequest endpoint */
-                    endpoint?: (string|null);
-
-                    /** SubscribeNotificationRequest p256dh */
-                    p256dh?: (string|null);
-
-                    /** SubscribeNotificationRequest auth */
-                    auth?: (string|null);
+                /** Properties of a GetNotificationPreferencesRequest. */
+                interface IGetNotificationPreferencesRequest {
                 }
 
-                /** Represents a SubscribeNotificationRequest. */
-                class SubscribeNotificationRequest implements ISubscribeNotificationRequest {
+                /** Represents a GetNotificationPreferencesRequest. */
+                class GetNotificationPreferencesRequest implements IGetNotificationPreferencesRequest {
 
                     /**
-                     * Constructs a new SubscribeNotificationRequest.
+                     * Constructs a new GetNotificationPreferencesRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationRequest);
-
-                    /** SubscribeNotificationRequest endpoint. */
-                    public endpoint: string;
-
-                    /** SubscribeNotificationRequest p256dh. */
-                    public p256dh: string;
-
-                    /** SubscribeNotificationRequest auth. */
-                    public auth: string;
+                    constructor(properties?: xsuportal.proto.services.contestant.IGetNotificationPreferencesRequest);
 
                     /**
-                     * Creates a new SubscribeNotificationRequest instance using the specified properties.
+                     * Creates a new GetNotificationPreferencesRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns SubscribeNotificationRequest instance
+                     * @returns GetNotificationPreferencesRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationRequest): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IGetNotificationPreferencesRequest): xsuportal.proto.services.contestant.GetNotificationPreferencesRequest;
 
                     /**
-                     * Encodes the specified SubscribeNotificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationRequest.verify|verify} messages.
-                     * @param message SubscribeNotificationRequest message or plain object to encode
+                     * Encodes the specified GetNotificationPreferencesRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.GetNotificationPreferencesRequest.verify|verify} messages.
+                     * @param message GetNotificationPreferencesRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ISubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IGetNotificationPreferencesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified SubscribeNotificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationRequest.verify|verify} messages.
-                     * @param message SubscribeNotificationRequest message or plain object to encode
+                     * Encodes the specified GetNotificationPreferencesRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetNotificationPreferencesRequest.verify|verify} messages.
+                     * @param message GetNotificationPreferencesRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ISubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetNotificationPreferencesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a SubscribeNotificationRequest message from the specified reader or buffer.
+                     * Decodes a GetNotificationPreferencesRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns SubscribeNotificationRequest
+                     * @returns GetNotificationPreferencesRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetNotificationPreferencesRequest;
 
                     /**
-                     * Decodes a SubscribeNotificationRequest message from the specified reader or buffer, length delimited.
+                     * Decodes a GetNotificationPreferencesRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns SubscribeNotificationRequest
+                     * @returns GetNotificationPreferencesRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetNotificationPreferencesRequest;
 
                     /**
-                     * Verifies a SubscribeNotificationRequest message.
+                     * Verifies a GetNotificationPreferencesRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a SubscribeNotificationRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates a GetNotificationPreferencesRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns SubscribeNotificationRequest
+                     * @returns GetNotificationPreferencesRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.SubscribeNotificationRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetNotificationPreferencesRequest;
 
                     /**
-                     * Creates a plain object from a SubscribeNotificationRequest message. Also converts values to other types if specified.
-                     * @param message SubscribeNotificationRequest
+                     * Creates a plain object from a GetNotificationPreferencesRequest message. Also converts values to other types if specified.
+                     * @param message GetNotificationPreferencesRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.SubscribeNotificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.GetNotificationPreferencesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this SubscribeNotificationRequest to JSON.
+                     * Converts this GetNotificationPreferencesRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of a SubscribeNotificationResponse. */
-                interface ISubscribeNotificationResponse {
+                /** Properties of a GetNotificationPreferencesResponse. */
+                interface IGetNotificationPreferencesResponse {
+
+                    /** GetNotificationPreferencesResponse preferences */
+                    preferences?: (xsuportal.proto.services.contestant.INotificationPreference[]|null);
                 }
 
-                /** Represents a SubscribeNotificationResponse. */
-                class SubscribeNotificationResponse implements ISubscribeNotificationResponse {
+                /** Represents a GetNotificationPreferencesResponse. */
+                class GetNotificationPreferencesResponse implements IGetNotificationPreferencesResponse {
 
                     /**
-                     * Constructs a new SubscribeNotificationResponse.
+                     * Constructs a new GetNotificationPreferencesResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IGetNotificationPreferencesResponse);
+
+                    /** GetNotificationPreferencesResponse preferences. */
+                    public preferences: xsuportal.proto.services.contestant.INotificationPreference[];
 
                     /**
-                     * Creates a new SubscribeNotificationResponse instance using the specified properties.
+                     * Creates a new GetNotificationPreferencesResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns SubscribeNotificationResponse instance
+                     * @returns GetNotificationPreferencesResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.ISubscribeNotificationResponse): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IGetNotificationPreferencesResponse): xsuportal.proto.services.contestant.GetNotificationPreferencesResponse;
 
                     /**
-                     * Encodes the specified SubscribeNotificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationResponse.verify|verify} messages.
-                     * @param message SubscribeNotificationResponse message or plain object to encode
+                     * Encodes the specified GetNotificationPreferencesResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.GetNotificationPreferencesResponse.verify|verify} messages.
+                     * @param message GetNotificationPreferencesResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.ISubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IGetNotificationPreferencesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified SubscribeNotificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.SubscribeNotificationResponse.verify|verify} messages.
-                     * @param message SubscribeNotificationResponse message or plain object to encode
+                     * Encodes the specified GetNotificationPreferencesResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.GetNotificationPreferencesResponse.verify|verify} messages.
+                     * @param message GetNotificationPreferencesResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.ISubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IGetNotificationPreferencesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes a SubscribeNotificationResponse message from the specified reader or buffer.
+                     * Decodes a GetNotificationPreferencesResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns SubscribeNotificationResponse
+                     * @returns GetNotificationPreferencesResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.GetNotificationPreferencesResponse;
 
                     /**
-                     * Decodes a SubscribeNotificationResponse message from the specified reader or buffer, length delimited.
+                     * Decodes a GetNotificationPreferencesResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns SubscribeNotificationResponse
+                     * @returns GetNotificationPreferencesResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.GetNotificationPreferencesResponse;
 
                     /**
-                     * Verifies a SubscribeNotificationResponse message.
+                     * Verifies a GetNotificationPreferencesResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates a SubscribeNotificationResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates a GetNotificationPreferencesResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns SubscribeNotificationResponse
+                     * @returns GetNotificationPreferencesResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.SubscribeNotificationResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.GetNotificationPreferencesResponse;
 
                     /**
-                     * Creates a plain object from a SubscribeNotificationResponse message. Also converts values to other types if specified.
-                     * @param message SubscribeNotificationResponse
+                     * Creates a plain object from a GetNotificationPreferencesResponse message. Also converts values to other types if specified.
+                     * @param message GetNotificationPreferencesResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.SubscribeNotificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.GetNotificationPreferencesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this SubscribeNotificationResponse to JSON.
+                     * Converts this GetNotificationPreferencesResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an UnsubscribeNotificationRequest. */
-                interface IUnsubscribeNotificationRequest {
+                /** Properties of an UpdateNotificationPreferencesRequest. */
+                interface IUpdateNotificationPreferencesRequest {
 
-                    /** UnsubscribeNotificationRequest endpoint */
-                    endpoint?: (string|null);
+                    /** UpdateNotificationPreferencesRequest preferences */
+                    preferences?: (xsuportal.proto.services.contestant.INotificationPreference[]|null);
                 }
 
-                /** Represents an UnsubscribeNotificationRequest. */
-                class UnsubscribeNotificationRequest implements IUnsubscribeNotificationRequest {
+                /** Represents an UpdateNotificationPreferencesRequest. */
+                class UpdateNotificationPreferencesRequest implements IUpdateNotificationPreferencesRequest {
 
                     /**
-                     * Constructs a new UnsubscribeNotificationRequest.
+                     * Constructs a new UpdateNotificationPreferencesRequest.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest);
+                    constructor(properties?: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesRequest);
 
-                    /** UnsubscribeNotificationRequest endpoint. */
-                    public endpoint: string;
+                    /** UpdateNotificationPreferencesRequest preferences. */
+                    public preferences: xsuportal.proto.services.contestant.INotificationPreference[];
 
                     /**
-                     * Creates a new UnsubscribeNotificationRequest instance using the specified properties.
+                     * Creates a new UpdateNotificationPreferencesRequest instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns UnsubscribeNotificationRequest instance
+                     * @returns UpdateNotificationPreferencesRequest instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
+                    public static create(properties?: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesRequest): xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest;
 
                     /**
-                     * Encodes the specified UnsubscribeNotificationRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationRequest.verify|verify} messages.
-                     * @param message UnsubscribeNotificationRequest message or plain object to encode
+                     * Encodes the specified UpdateNotificationPreferencesRequest message. Does not implicitly {@link xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest.verify|verify} messages.
+                     * @param message UpdateNotificationPreferencesRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified UnsubscribeNotificationRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationRequest.verify|verify} messages.
-                     * @param message UnsubscribeNotificationRequest message or plain object to encode
+                     * Encodes the specified UpdateNotificationPreferencesRequest message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest.verify|verify} messages.
+                     * @param message UpdateNotificationPreferencesRequest message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesRequest, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an UnsubscribeNotificationRequest message from the specified reader or buffer.
+                     * Decodes an UpdateNotificationPreferencesRequest message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns UnsubscribeNotificationRequest
+                     * @returns UpdateNotificationPreferencesRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest;
 
                     /**
-                     * Decodes an UnsubscribeNotificationRequest message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateNotificationPreferencesRequest message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns UnsubscribeNotificationRequest
+                     * @returns UpdateNotificationPreferencesRequest
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest;
 
                     /**
-                     * Verifies an UnsubscribeNotificationRequest message.
+                     * Verifies an UpdateNotificationPreferencesRequest message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an UnsubscribeNotificationRequest message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateNotificationPreferencesRequest message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns UnsubscribeNotificationRequest
+                     * @returns UpdateNotificationPreferencesRequest
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UnsubscribeNotificationRequest;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest;
 
                     /**
-                     * Creates a plain object from an UnsubscribeNotificationRequest message. Also converts values to other types if specified.
-                     * @param message UnsubscribeNotificationRequest
+                     * Creates a plain object from an UpdateNotificationPreferencesRequest message. Also converts values to other types if specified.
+                     * @param message UpdateNotificationPreferencesRequest
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.UnsubscribeNotificationRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.UpdateNotificationPreferencesRequest, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this UnsubscribeNotificationRequest to JSON.
+                     * Converts this UpdateNotificationPreferencesRequest to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
                 }
 
-                /** Properties of an UnsubscribeNotificationResponse. */
-                interface IUnsubscribeNotificationResponse {
+                /** Properties of an UpdateNotificationPreferencesResponse. */
+                interface IUpdateNotificationPreferencesResponse {
+
+                    /** UpdateNotificationPreferencesResponse preferences */
+                    preferences?: (xsuportal.proto.services.contestant.INotificationPreference[]|null);
                 }
 
-                /** Represents an UnsubscribeNotificationResponse. */
-                class UnsubscribeNotificationResponse implements IUnsubscribeNotificationResponse {
+                /** Represents an UpdateNotificationPreferencesResponse. */
+                class UpdateNotificationPreferencesResponse implements IUpdateNotificationPreferencesResponse {
 
                     /**
-                     * Constructs a new UnsubscribeNotificationResponse.
+                     * Constructs a new UpdateNotificationPreferencesResponse.
                      * @param [properties] Properties to set
                      */
-                    constructor(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse);
+                    constructor(properties?: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesResponse);
+
+                    /** UpdateNotificationPreferencesResponse preferences. */
+                    public preferences: xsuportal.proto.services.contestant.INotificationPreference[];
 
                     /**
-                     * Creates a new UnsubscribeNotificationResponse instance using the specified properties.
+                     * Creates a new UpdateNotificationPreferencesResponse instance using the specified properties.
                      * @param [properties] Properties to set
-                     * @returns UnsubscribeNotificationResponse instance
+                     * @returns UpdateNotificationPreferencesResponse instance
                      */
-                    public static create(properties?: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
+                    public static create(properties?: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesResponse): xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse;
 
                     /**
-                     * Encodes the specified UnsubscribeNotificationResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationResponse.verify|verify} messages.
-                     * @param message UnsubscribeNotificationResponse message or plain object to encode
+                     * Encodes the specified UpdateNotificationPreferencesResponse message. Does not implicitly {@link xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse.verify|verify} messages.
+                     * @param message UpdateNotificationPreferencesResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encode(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encode(message: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Encodes the specified UnsubscribeNotificationResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UnsubscribeNotificationResponse.verify|verify} messages.
-                     * @param message UnsubscribeNotificationResponse message or plain object to encode
+                     * Encodes the specified UpdateNotificationPreferencesResponse message, length delimited. Does not implicitly {@link xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse.verify|verify} messages.
+                     * @param message UpdateNotificationPreferencesResponse message or plain object to encode
                      * @param [writer] Writer to encode to
                      * @returns Writer
                      */
-                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUnsubscribeNotificationResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+                    public static encodeDelimited(message: xsuportal.proto.services.contestant.IUpdateNotificationPreferencesResponse, writer?: $protobuf.Writer): $protobuf.Writer;
 
                     /**
-                     * Decodes an UnsubscribeNotificationResponse message from the specified reader or buffer.
+                     * Decodes an UpdateNotificationPreferencesResponse message from the specified reader or buffer.
                      * @param reader Reader or buffer to decode from
                      * @param [length] Message length if known beforehand
-                     * @returns UnsubscribeNotificationResponse
+                     * @returns UpdateNotificationPreferencesResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
+                    public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse;
 
                     /**
-                     * Decodes an UnsubscribeNotificationResponse message from the specified reader or buffer, length delimited.
+                     * Decodes an UpdateNotificationPreferencesResponse message from the specified reader or buffer, length delimited.
                      * @param reader Reader or buffer to decode from
-                     * @returns UnsubscribeNotificationResponse
+                     * @returns UpdateNotificationPreferencesResponse
                      * @throws {Error} If the payload is not a reader or valid buffer
                      * @throws {$protobuf.util.ProtocolError} If required fields are missing
                      */
-                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
+                    public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse;
 
                     /**
-                     * Verifies an UnsubscribeNotificationResponse message.
+                     * Verifies an UpdateNotificationPreferencesResponse message.
                      * @param message Plain object to verify
                      * @returns `null` if valid, otherwise the reason why it is not
                      */
                     public static verify(message: { [k: string]: any }): (string|null);
 
                     /**
-                     * Creates an UnsubscribeNotificationResponse message from a plain object. Also converts values to their respective internal types.
+                     * Creates an UpdateNotificationPreferencesResponse message from a plain object. Also converts values to their respective internal types.
                      * @param object Plain object
-                     * @returns UnsubscribeNotificationResponse
+                     * @returns UpdateNotificationPreferencesResponse
                      */
-                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UnsubscribeNotificationResponse;
+                    public static fromObject(object: { [k: string]: any }): xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse;
 
                     /**
-                     * Creates a plain object from an UnsubscribeNotificationResponse message. Also converts values to other types if specified.
-                     * @param message UnsubscribeNotificationResponse
+                     * Creates a plain object from an UpdateNotificationPreferencesResponse message. Also converts values to other types if specified.
+                     * @param message UpdateNotificationPreferencesResponse
                      * @param [options] Conversion options
                      * @returns Plain object
                      */
-                    public static toObject(message: xsuportal.proto.services.contestant.UnsubscribeNotificationResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
+                    public static toObject(message: xsuportal.proto.services.contestant.UpdateNotificationPreferencesResponse, options?: $protobuf.IConversionOptions): { [k: string]: any };
 
                     /**
-                     * Converts this UnsubscribeNotificationResponse to JSON.
+                     * Converts this UpdateNotificationPreferencesResponse to JSON.
                      * @returns JSON object
                      */
                     public toJSON(): { [k: string]: any };
@@ -236,6 +236,9 @@ export namespace xsuportal {
 
                     /** BenchmarkJobMessage benchmarkJobId */
                     benchmarkJobId?: (number|Long|null);
+
+                    /** BenchmarkJobMessage requeued */
+                    requeued?: (boolean|null);
                 }
 
                 /** Represents a BenchmarkJobMessage. */
@@ -250,6 +253,9 @@ export namespace xsuportal {
                     /** BenchmarkJobMessage benchmarkJobId. */
                     public benchmarkJobId: (number|Long);
 
+                    /** BenchmarkJobMessage requeued. */
+                    public requeued: boolean;
+
                     /**
                      * Creates a new BenchmarkJobMessage instance using the specified properties.
                      * @param [properties] Properties to set
@@ -632,6 +632,7 @@ $root.xsuportal = (function() {
                      * @memberof xsuportal.proto.resources.Notification
                      * @interface IBenchmarkJobMessage
                      * @property {number|Long|null} [benchmarkJobId] BenchmarkJobMessage benchmarkJobId
+                     * @property {boolean|null} [requeued] BenchmarkJobMessage requeued
                      */
 
                     /**
@@ -657,6 +658,14 @@ $root.xsuportal = (function() {
                      */
                     BenchmarkJobMessage.prototype.benchmarkJobId = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
 
+                    /**
+                     * BenchmarkJobMessage requeued.
+                     * @member {boolean} requeued
+                     * @memberof xsuportal.proto.resources.Notification.BenchmarkJobMessage
+                     * @instance
+                     */
+                    BenchmarkJobMessage.prototype.requeued = false;
+
                     /**
                      * Creates a new BenchmarkJobMessage instance using the specified properties.
                      * @function create
@@ -683,6 +692,8 @@ $root.xsuportal = (function() {
                             writer = $Writer.create();
                         if (message.benchmarkJobId != null && Object.hasOwnProperty.call(message, "benchmarkJobId"))
                             writer.uint32(/* id 1, wireType 0 =*/8).int64(message.benchmarkJobId);
+                        if (message.requeued != null && Object.hasOwnProperty.call(message, "requeued"))
+                            writer.uint32(/* id 2, wireType 0 =*/16).bool(message.requeued);
                         return writer;
                     };
 
@@ -720,6 +731,9 @@ $root.xsuportal = (function() {
                             case 1:
                                 message.benchmarkJobId = reader.int64();
                                 break;
+                            case 2:
+                                message.requeued = reader.bool();
+                                break;
                             default:
                                 reader.skipType(tag & 7);
                                 break;
@@ -758,6 +772,9 @@ $root.xsuportal = (function() {
                         if (message.benchmarkJobId != null && message.hasOwnProperty("benchmarkJobId"))
                             if (!$util.isInteger(message.benchmarkJobId) && !(message.benchmarkJobId && $util.isInteger(message.benchmarkJobId.low) && $util.isInteger(message.benchmarkJobId.high)))
                                 return "benchmarkJobId: integer|Long expected";
+                        if (message.requeued != null && message.hasOwnProperty("requeued"))
+                            if (typeof message.requeued !== "boolean")
+                                return "requeued: boolean expected";
                         return null;
                     };
 
@@ -782,6 +799,8 @@ $root.xsuportal = (function() {
                                 message.benchmarkJobId = object.benchmarkJobId;
                             else if (typeof object.benchmarkJobId === "object")
                                 message.benchmarkJobId = new $util.LongBits(object.benchmarkJobId.low >>> 0, object.benchmarkJobId.high >>> 0).toNumber();
+                        if (object.requeued != null)
+                            message.requeued = Boolean(object.requeued);
                         return message;
                     };
 
@@ -798,17 +817,21 @@ $root.xsuportal = (function() {
                         if (!options)
                             options = {};
                         var object = {};
-                        if (options.defaults)
+                        if (options.defaults) {
                             if ($util.Long) {
                                 var long = new $util.Long(0, 0, false);
                                 object.benchmarkJobId = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
                             } else
                                 object.benchmarkJobId = options.longs === String ? "0" : 0;
+                            object.requeued = false;
+                        }
                         if (message.benchmarkJobId != null && message.hasOwnProperty("benchmarkJobId"))
                             if (typeof message.benchmarkJobId === "number")
                                 object.benchmarkJobId = options.longs === String ? String(message.benchmarkJobId) : message.benchmarkJobId;
                             else
                                 object.benchmarkJobId = options.longs === String ? $util.Long.prototype.toString.call(message.benchmarkJobId) : options.longs === Number ? new $util.LongBits(message.benchmarkJobId.low >>> 0, message.benchmarkJobId.high >>> 0).toNumber() : message.benchmarkJobId;
+                        if (message.requeued != null && message.hasOwnProperty("requeued"))
+                            object.requeued = message.requeued;
                         return object;
                     };
 
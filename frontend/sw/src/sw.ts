@@ -78,6 +78,14 @@ const showNotification = async (n: xsuportal.proto.resources.INotification) => {
         data: `/contestant`,
       }
     );
+  } else if (n.contentBenchmarkJob && n.contentBenchmarkJob.requeued) {
+    promise = self.registration.showNotification(`Benchmark Job Requeued`, {
+      body: `Benchmark Job #${n.contentBenchmarkJob
+        .benchmarkJobId!} got no response from the benchmarker and will be run again.`,
+      data: `/contestant/benchmark_jobs/${n.contentBenchmarkJob
+        .benchmarkJobId!}`,
+      tag,
+    });
   } else if (n.contentBenchmarkJob) {
     promise = self.registration.showNotification(`Benchmark Job Completed`, {
       body: `Benchmark Job #${n.contentBenchmarkJob
@@ -0,0 +1,115 @@
+package xsuportal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const RehearsalClockPollInterval = time.Second
+
+// Clock はコンテストの進行に使う現在時刻を返す。
+// At は実際の時刻 (ベンチマーカーが報告した時刻など) を Clock 上の時刻に直す。
+type Clock interface {
+	Now() time.Time
+	At(t time.Time) time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) At(t time.Time) time.Time {
+	return t
+}
+
+// RehearsalClock はリハーサル用の時計で、実際の時刻に Offset を足すか、FixedAt が設定されていればその時刻で止まる。
+// 設定は rehearsal_clock テーブルに保存され、Run しているプロセス同士で共有される。
+type RehearsalClock struct {
+	mu      sync.RWMutex
+	offset  time.Duration
+	fixedAt sql.NullTime
+}
+
+type rehearsalClockSetting struct {
+	Offset  int64        `db:"offset_microseconds"`
+	FixedAt sql.NullTime `db:"fixed_at"`
+}
+
+func (c *RehearsalClock) Now() time.Time {
+	return c.At(time.Now())
+}
+
+func (c *RehearsalClock) At(t time.Time) time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.fixedAt.Valid {
+		return c.fixedAt.Time
+	}
+	return t.Add(c.offset)
+}
+
+func (c *RehearsalClock) Setting() (time.Duration, sql.NullTime) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset, c.fixedAt
+}
+
+func (c *RehearsalClock) set(offset time.Duration, fixedAt sql.NullTime) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset = offset
+	c.fixedAt = fixedAt
+}
+
+// Load は保存されている設定を読み込む。保存されていなければ実際の時刻どおりに進む。
+func (c *RehearsalClock) Load(db sqlx.Queryer) error {
+	var setting rehearsalClockSetting
+	err := sqlx.Get(
+		db,
+		&setting,
+		"SELECT `offset_microseconds`, `fixed_at` FROM `rehearsal_clock` WHERE `id` = 1 LIMIT 1",
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("get rehearsal clock: %w", err)
+	}
+	c.set(time.Duration(setting.Offset)*time.Microsecond, setting.FixedAt)
+	return nil
+}
+
+// Save は設定を保存してすぐに反映する。他のプロセスには次の Load で反映される。
+func (c *RehearsalClock) Save(db sqlx.Execer, offset time.Duration, fixedAt sql.NullTime) error {
+	_, err := db.Exec(
+		"INSERT INTO `rehearsal_clock` (`id`, `offset_microseconds`, `fixed_at`, `updated_at`) VALUES (1, ?, ?, NOW(6)) ON DUPLICATE KEY UPDATE `offset_microseconds` = VALUES(`offset_microseconds`), `fixed_at` = VALUES(`fixed_at`), `updated_at` = NOW(6)",
+		offset.Microseconds(),
+		fixedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("save rehearsal clock: %w", err)
+	}
+	c.set(offset, fixedAt)
+	return nil
+}
+
+// Run は ctx がキャンセルされるまで設定を読み込み続ける。
+func (c *RehearsalClock) Run(ctx context.Context, db *sqlx.DB) {
+	ticker := time.NewTicker(RehearsalClockPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.Load(db); err != nil {
+			log.Printf("[WARN] load rehearsal clock: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
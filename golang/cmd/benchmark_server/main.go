@@ -5,9 +5,12 @@ import (
 	"crypto/rand"
 	"database/sql"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -23,8 +26,14 @@ import (
 )
 
 var db *sqlx.DB
+var notifier xsuportal.Notifier
+
+// ベンチマーカーが報告する時刻は実際の時刻なので、clock.At でコンテストの時計に直してから保存する
+var clock xsuportal.Clock = xsuportal.SystemClock{}
 
 type benchmarkQueueService struct {
+	queue  *jobQueue
+	policy schedulingPolicy
 }
 
 func (b *benchmarkQueueService) Svc() *bench.BenchmarkQueueService {
@@ -33,9 +42,37 @@ func (b *benchmarkQueueService) Svc() *bench.BenchmarkQueueService {
 	}
 }
 
+// ReceiveBenchmarkJob はジョブが積まれるか、ctx の期限か MaxWait が来るまでブロックする。
+// 期限までにジョブがなければ JobHandle が空のレスポンスを返す。
 func (b *benchmarkQueueService) ReceiveBenchmarkJob(ctx context.Context, req *bench.ReceiveBenchmarkJobRequest) (*bench.ReceiveBenchmarkJobResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.queue.MaxWait)
+	defer cancel()
+
+	var jobHandle *bench.ReceiveBenchmarkJobResponse_JobHandle
+	for {
+		// 取得に失敗してから待ち始めるまでの間に積まれたジョブを取りこぼさないよう、先に待ち受けを用意する
+		wakeup := b.queue.Wakeup()
+		var err error
+		jobHandle, err = b.dequeue(req.TeamId)
+		if err != nil {
+			return nil, fmt.Errorf("fetch queue: %w", err)
+		}
+		if jobHandle != nil || !b.queue.Wait(ctx, wakeup) {
+			break
+		}
+	}
+	if jobHandle != nil {
+		log.Printf("[DEBUG] Dequeued: job_handle=%+v", jobHandle)
+	}
+	return &bench.ReceiveBenchmarkJobResponse{
+		JobHandle: jobHandle,
+	}, nil
+}
+
+// dequeue は policy が選んだ PENDING のジョブを 1 件 SENT にして返す。
+// 行ロックを取ってから状態を確かめ直すので、複数のベンチマークサーバーが同時に呼んでも同じジョブを二重に渡さない。
+func (b *benchmarkQueueService) dequeue(teamID int64) (*bench.ReceiveBenchmarkJobResponse_JobHandle, error) {
 	var jobHandle *bench.ReceiveBenchmarkJobResponse_JobHandle
-	// TODO: 実質全件取得してる気がする、ただpollBenchmarkJobが50ms待ちしてるから考える必要あり
 	for {
 		next, err := func() (bool, error) {
 			tx, err := db.Beginx()
@@ -44,9 +81,17 @@ func (b *benchmarkQueueService) ReceiveBenchmarkJob(ctx context.Context, req *be
 			}
 			defer tx.Rollback()
 
-			job, err := pollBenchmarkJob(tx)
+			// アクティブなコンテストがなければ渡すジョブもない
+			contest, err := xsuportal.GetActiveContest(tx)
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			job, err := b.policy.Next(tx, contest.ID, teamID)
 			if err != nil {
-				return false, fmt.Errorf("poll benchmark job: %w", err)
+				return false, fmt.Errorf("next pending job: %w", err)
 			}
 			if job == nil {
 				return false, nil
@@ -65,16 +110,16 @@ func (b *benchmarkQueueService) ReceiveBenchmarkJob(ctx context.Context, req *be
 			if err != nil {
 				return false, fmt.Errorf("get benchmark job with lock: %w", err)
 			}
-			randomBytes := make([]byte, 16)
-			_, err = rand.Read(randomBytes)
+			handle, err := newJobHandle()
 			if err != nil {
-				return false, fmt.Errorf("read random: %w", err)
+				return false, err
 			}
-			handle := base64.StdEncoding.EncodeToString(randomBytes)
+			dispatchedAt := clock.Now()
 			_, err = tx.Exec(
-				"UPDATE `benchmark_jobs` SET `status` = ?, `handle` = ? WHERE `id` = ? AND `status` = ? LIMIT 1",
+				"UPDATE `benchmark_jobs` SET `status` = ?, `handle` = ?, `dispatched_at` = ?, `updated_at` = NOW(6) WHERE `id` = ? AND `status` = ? LIMIT 1",
 				resources.BenchmarkJob_SENT,
 				handle,
+				dispatchedAt,
 				job.ID,
 				resources.BenchmarkJob_PENDING,
 			)
@@ -82,12 +127,6 @@ func (b *benchmarkQueueService) ReceiveBenchmarkJob(ctx context.Context, req *be
 				return false, fmt.Errorf("update benchmark job status: %w", err)
 			}
 
-			var contestStartsAt time.Time
-			err = tx.Get(&contestStartsAt, "SELECT `contest_starts_at` FROM `contest_config` LIMIT 1")
-			if err != nil {
-				return false, fmt.Errorf("get contest starts at: %w", err)
-			}
-
 			if err := tx.Commit(); err != nil {
 				return false, fmt.Errorf("commit tx: %w", err)
 			}
@@ -96,24 +135,18 @@ func (b *benchmarkQueueService) ReceiveBenchmarkJob(ctx context.Context, req *be
 				JobId:            job.ID,
 				Handle:           handle,
 				TargetHostname:   job.TargetHostName,
-				ContestStartedAt: timestamppb.New(contestStartsAt),
+				ContestStartedAt: timestamppb.New(contest.ContestStartsAt),
 				JobCreatedAt:     timestamppb.New(job.CreatedAt),
 			}
 			return false, nil
 		}()
 		if err != nil {
-			return nil, fmt.Errorf("fetch queue: %w", err)
+			return nil, err
 		}
 		if !next {
-			break
+			return jobHandle, nil
 		}
 	}
-	if jobHandle != nil {
-		log.Printf("[DEBUG] Dequeued: job_handle=%+v", jobHandle)
-	}
-	return &bench.ReceiveBenchmarkJobResponse{
-		JobHandle: jobHandle,
-	}, nil
 }
 
 type benchmarkReportService struct {
@@ -125,60 +158,21 @@ func (b *benchmarkReportService) Svc() *bench.BenchmarkReportService {
 	}
 }
 
+// ReportBenchmarkResult は (job_id, nonce) ごとに一度だけ報告を反映する。
+// 再接続などで同じ nonce が再送された場合は反映せずに ack し、既に処理した nonce より古い未処理の nonce は拒否する。
+// 報告ごとの検証エラー (古い nonce やキャンセル済みのジョブなど) はログに出して ack せずに読み飛ばし、ストリームは閉じない。
+// ストリームを閉じるのは受信・送信に失敗したときと DB などの内部エラーのときだけ。
 func (b *benchmarkReportService) ReportBenchmarkResult(srv bench.BenchmarkReport_ReportBenchmarkResultServer) error {
-	var notifier xsuportal.Notifier
 	for {
 		req, err := srv.Recv()
 		if err != nil {
 			return err
 		}
-		if req.Result == nil {
-			return status.Error(codes.InvalidArgument, "result required")
+		err = b.report(req)
+		if _, ok := status.FromError(err); err != nil && ok {
+			log.Printf("[WARN] Rejected report: job_id=%v, nonce=%v: %v", req.JobId, req.Nonce, err)
+			continue
 		}
-
-		err = func() error {
-			tx, err := db.Beginx()
-			if err != nil {
-				return fmt.Errorf("begin tx: %w", err)
-			}
-			defer tx.Rollback()
-
-			var job xsuportal.BenchmarkJob
-			err = tx.Get(
-				&job,
-				"SELECT * FROM `benchmark_jobs` WHERE `id` = ? AND `handle` = ? LIMIT 1 FOR UPDATE",
-				req.JobId,
-				req.Handle,
-			)
-			if err == sql.ErrNoRows {
-				log.Printf("[ERROR] Job not found: job_id=%v, handle=%+v", req.JobId, req.Handle)
-				return status.Errorf(codes.NotFound, "Job %d not found or handle is wrong", req.JobId)
-			}
-			if err != nil {
-				return fmt.Errorf("get benchmark job: %w", err)
-			}
-			if req.Result.Finished {
-				log.Printf("[DEBUG] %v: save as finished", req.JobId)
-				if err := b.saveAsFinished(tx, &job, req); err != nil {
-					return err
-				}
-				if err := tx.Commit(); err != nil {
-					return fmt.Errorf("commit tx: %w", err)
-				}
-				if err := notifier.NotifyBenchmarkJobFinished(db, &job); err != nil {
-					return fmt.Errorf("notify benchmark job finished: %w", err)
-				}
-			} else {
-				log.Printf("[DEBUG] %v: save as running", req.JobId)
-				if err := b.saveAsRunning(tx, &job, req); err != nil {
-					return err
-				}
-				if err := tx.Commit(); err != nil {
-					return fmt.Errorf("commit tx: %w", err)
-				}
-			}
-			return nil
-		}()
 		if err != nil {
 			return err
 		}
@@ -191,6 +185,107 @@ func (b *benchmarkReportService) ReportBenchmarkResult(srv bench.BenchmarkReport
 	}
 }
 
+func (b *benchmarkReportService) report(req *bench.ReportBenchmarkResultRequest) error {
+	if req.Result == nil {
+		return status.Error(codes.InvalidArgument, "result required")
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var job xsuportal.BenchmarkJob
+	err = tx.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? AND `handle` = ? LIMIT 1 FOR UPDATE",
+		req.JobId,
+		req.Handle,
+	)
+	if err == sql.ErrNoRows {
+		log.Printf("[ERROR] Job not found: job_id=%v, handle=%+v", req.JobId, req.Handle)
+		return status.Errorf(codes.NotFound, "Job %d not found or handle is wrong", req.JobId)
+	}
+	if err != nil {
+		return fmt.Errorf("get benchmark job: %w", err)
+	}
+
+	err = xsuportal.CheckContestWritable(tx, job.ContestID)
+	if errors.Is(err, xsuportal.ErrContestArchived) {
+		return status.Errorf(codes.FailedPrecondition, "Contest %d of job %d has been archived", job.ContestID, req.JobId)
+	}
+	if err != nil {
+		return err
+	}
+
+	// ジョブの行ロックを取っているので、同じジョブの報告はここから先で直列になる
+	var lastNonce sql.NullInt64
+	err = tx.Get(
+		&lastNonce,
+		"SELECT MAX(`nonce`) FROM `benchmark_job_reports` WHERE `benchmark_job_id` = ?",
+		job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("get last nonce: %w", err)
+	}
+	if lastNonce.Valid && req.Nonce <= lastNonce.Int64 {
+		var processed bool
+		err = tx.Get(
+			&processed,
+			"SELECT 1 FROM `benchmark_job_reports` WHERE `benchmark_job_id` = ? AND `nonce` = ? LIMIT 1",
+			job.ID,
+			req.Nonce,
+		)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.FailedPrecondition, "Nonce %d is older than the last processed nonce %d", req.Nonce, lastNonce.Int64)
+		}
+		if err != nil {
+			return fmt.Errorf("get processed report: %w", err)
+		}
+		log.Printf("[DEBUG] %v: duplicated nonce %v", req.JobId, req.Nonce)
+		return nil
+	}
+
+	switch resources.BenchmarkJob_Status(job.Status) {
+	case resources.BenchmarkJob_CANCELLED:
+		log.Printf("[INFO] Job cancelled: job_id=%v", req.JobId)
+		return status.Errorf(codes.FailedPrecondition, "Job %d has been cancelled", req.JobId)
+	case resources.BenchmarkJob_ERRORED:
+		log.Printf("[INFO] Job errored: job_id=%v", req.JobId)
+		return status.Errorf(codes.FailedPrecondition, "Job %d has been marked as errored", req.JobId)
+	}
+	if req.Result.Finished {
+		log.Printf("[DEBUG] %v: save as finished", req.JobId)
+		if err := b.saveAsFinished(tx, &job, req); err != nil {
+			return err
+		}
+		if err := notifier.NotifyBenchmarkJobFinished(tx, &job); err != nil {
+			return fmt.Errorf("notify benchmark job finished: %w", err)
+		}
+	} else {
+		log.Printf("[DEBUG] %v: save as running", req.JobId)
+		if err := b.saveAsRunning(tx, &job, req); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(
+		"INSERT INTO `benchmark_job_reports` (`benchmark_job_id`, `nonce`, `created_at`) VALUES (?, ?, NOW(6))",
+		job.ID,
+		req.Nonce,
+	)
+	if err != nil {
+		return fmt.Errorf("insert benchmark job report: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	if req.Result.Finished {
+		notifier.Outbox().Wakeup()
+	}
+	return nil
+}
+
 func (b *benchmarkReportService) saveAsFinished(db sqlx.Execer, job *xsuportal.BenchmarkJob, req *bench.ReportBenchmarkResultRequest) error {
 	if !job.StartedAt.Valid || job.FinishedAt.Valid {
 		return status.Errorf(codes.FailedPrecondition, "Job %v has already finished or has not started yet", req.JobId)
@@ -198,7 +293,7 @@ func (b *benchmarkReportService) saveAsFinished(db sqlx.Execer, job *xsuportal.B
 	if req.Result.MarkedAt == nil {
 		return status.Errorf(codes.InvalidArgument, "marked_at is required")
 	}
-	markedAt := req.Result.MarkedAt.AsTime().Round(time.Microsecond)
+	markedAt := clock.At(req.Result.MarkedAt.AsTime()).Round(time.Microsecond)
 
 	result := req.Result
 	var raw, deduction sql.NullInt32
@@ -232,7 +327,7 @@ func (b *benchmarkReportService) saveAsRunning(db sqlx.Execer, job *xsuportal.Be
 	if job.StartedAt.Valid {
 		startedAt = job.StartedAt.Time
 	} else {
-		startedAt = req.Result.MarkedAt.AsTime().Round(time.Microsecond)
+		startedAt = clock.At(req.Result.MarkedAt.AsTime()).Round(time.Microsecond)
 	}
 	_, err := db.Exec(
 		"UPDATE `benchmark_jobs` SET `status` = ?, `score_raw` = NULL, `score_deduction` = NULL, `passed` = FALSE, `reason` = NULL, `started_at` = ?, `updated_at` = NOW(6), `finished_at` = NULL WHERE `id` = ? LIMIT 1",
@@ -243,34 +338,47 @@ func (b *benchmarkReportService) saveAsRunning(db sqlx.Execer, job *xsuportal.Be
 	if err != nil {
 		return fmt.Errorf("update benchmark job status: %w", err)
 	}
+
+	// 途中経過はジョブには残さず、タイムラインとして積んでいく
+	var raw, deduction sql.NullInt32
+	if req.Result.ScoreBreakdown != nil {
+		raw.Valid = true
+		raw.Int32 = int32(req.Result.ScoreBreakdown.Raw)
+		deduction.Valid = true
+		deduction.Int32 = int32(req.Result.ScoreBreakdown.Deduction)
+	}
+	_, err = db.Exec(
+		"INSERT INTO `benchmark_job_progresses` (`benchmark_job_id`, `score_raw`, `score_deduction`, `marked_at`, `created_at`) VALUES (?, ?, ?, ?, NOW(6))",
+		req.JobId,
+		raw,
+		deduction,
+		clock.At(req.Result.MarkedAt.AsTime()).Round(time.Microsecond),
+	)
+	if err != nil {
+		return fmt.Errorf("insert benchmark job progress: %w", err)
+	}
 	return nil
 }
 
-func pollBenchmarkJob(db sqlx.Queryer) (*xsuportal.BenchmarkJob, error) {
-	// TODO: ポーリングじゃない方法がとれないか検討
-	for i := 0; i < 10; i++ {
-		if i >= 1 {
-			time.Sleep(50 * time.Millisecond)
-		}
-		var job xsuportal.BenchmarkJob
-		err := sqlx.Get(
-			db,
-			&job,
-			"SELECT * FROM `benchmark_jobs` WHERE `status` = ? ORDER BY `id` LIMIT 1",
-			resources.BenchmarkJob_PENDING,
-		)
-		if err == sql.ErrNoRows {
-			continue
-		}
-		if err != nil {
-			return nil, fmt.Errorf("get benchmark job: %w", err)
-		}
-		return &job, nil
+func newJobHandle() (string, error) {
+	randomBytes := make([]byte, 16)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("read random: %w", err)
 	}
-	return nil, nil
+	return base64.StdEncoding.EncodeToString(randomBytes), nil
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		db, _ = xsuportal.GetDB()
+		xsuportal.WaitDB(db)
+		if err := xsuportal.RunMigrateCommand(db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	port := util.GetEnv("PORT", "50051")
 	address := ":" + port
 
@@ -285,9 +393,51 @@ func main() {
 	xsuportal.WaitDB(db)
 	go xsuportal.PollDB(db)
 
+	if err := xsuportal.MigrateOnStart(db); err != nil {
+		panic(err)
+	}
+
+	if rehearsal, _ := strconv.ParseBool(util.GetEnv("XSUPORTAL_REHEARSAL", "false")); rehearsal {
+		rehearsalClock := &xsuportal.RehearsalClock{}
+		if err := rehearsalClock.Load(db); err != nil {
+			panic(err)
+		}
+		go rehearsalClock.Run(context.Background(), db)
+		clock = rehearsalClock
+	}
+	notifier.Clock = clock
+
+	notifierWorkers, _ := strconv.Atoi(util.GetEnv("NOTIFIER_WORKERS", "4"))
+	go notifier.Run(context.Background(), db, notifierWorkers)
+
+	lease, err := time.ParseDuration(util.GetEnv("BENCHMARK_JOB_LEASE", "3m"))
+	if err != nil {
+		panic(err)
+	}
+	tailInterval, err := time.ParseDuration(util.GetEnv("BENCHMARK_QUEUE_TAIL_INTERVAL", "50ms"))
+	if err != nil {
+		panic(err)
+	}
+	maxWait, err := time.ParseDuration(util.GetEnv("BENCHMARK_QUEUE_MAX_WAIT", "5s"))
+	if err != nil {
+		panic(err)
+	}
+	jobs := &jobQueue{TailInterval: tailInterval, MaxWait: maxWait}
+	go jobs.Tail(context.Background())
+
+	maxRequeues, _ := strconv.Atoi(util.GetEnv("BENCHMARK_JOB_MAX_REQUEUES", "1"))
+	reaper := &jobReaper{Lease: lease, MaxRequeues: maxRequeues, Queue: jobs}
+	go reaper.Run(context.Background())
+
 	server := grpc.NewServer()
 
-	queue := &benchmarkQueueService{}
+	policy, err := newSchedulingPolicy(util.GetEnv("BENCHMARK_QUEUE_POLICY", "fifo"))
+	if err != nil {
+		panic(err)
+	}
+	log.Print("[INFO] scheduling policy: ", policy.Name())
+
+	queue := &benchmarkQueueService{queue: jobs, policy: policy}
 	report := &benchmarkReportService{}
 
 	bench.RegisterBenchmarkQueueService(server, queue.Svc())
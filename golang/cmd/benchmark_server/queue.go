@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// jobQueue は ReceiveBenchmarkJob で待っているワーカーを、ジョブが積まれたときに起こす。
+// ジョブはポータルなど別プロセスから積まれるので、待っているワーカーがいる間だけ
+// TailInterval ごとに benchmark_jobs の最大の id を確かめ、前回より増えていたときだけ通知する。
+// 取れない PENDING のジョブ (他のチーム専用のものなど) が残っていても、新しいジョブが来るまでは起こさない。
+// 再実行に戻したジョブは id が増えないので、戻した側 (jobReaper) が Notify する。
+type jobQueue struct {
+	TailInterval time.Duration
+	MaxWait      time.Duration
+
+	mu      sync.Mutex
+	wakeup  chan struct{}
+	waiting int
+
+	lastJobID int64
+}
+
+// Wakeup は次の Notify で閉じられるチャネルを返す
+func (q *jobQueue) Wakeup() <-chan struct{} {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.wakeup == nil {
+		q.wakeup = make(chan struct{})
+	}
+	return q.wakeup
+}
+
+// Notify は待っている全てのワーカーを起こす
+func (q *jobQueue) Notify() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.wakeup != nil {
+		close(q.wakeup)
+	}
+	q.wakeup = make(chan struct{})
+}
+
+// Wait は wakeup が閉じられれば true を、ctx が終われば false を返す
+func (q *jobQueue) Wait(ctx context.Context, wakeup <-chan struct{}) bool {
+	q.mu.Lock()
+	q.waiting++
+	q.mu.Unlock()
+	defer func() {
+		q.mu.Lock()
+		q.waiting--
+		q.mu.Unlock()
+	}()
+	select {
+	case <-wakeup:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (q *jobQueue) hasWaiters() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.waiting > 0
+}
+
+func (q *jobQueue) Tail(ctx context.Context) {
+	ticker := time.NewTicker(q.TailInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !q.hasWaiters() {
+			continue
+		}
+		var lastJobID int64
+		err := db.Get(&lastJobID, "SELECT COALESCE(MAX(`id`), 0) FROM `benchmark_jobs`")
+		if err != nil {
+			log.Printf("[WARN] tail benchmark jobs: %v", err)
+			continue
+		}
+		if lastJobID <= q.lastJobID {
+			continue
+		}
+		q.lastJobID = lastJobID
+		q.Notify()
+	}
+}
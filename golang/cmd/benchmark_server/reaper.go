@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	xsuportal "github.com/isucon/isucon10-final/webapp/golang"
+	"github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+// jobReaper は SENT/RUNNING のまま Lease 以上報告のないジョブを回収する。
+// MaxRequeues 回までは新しいハンドルで PENDING に戻し、それを超えたら ERRORED にする。
+// どちらの場合もチームに通知するが、再実行に戻したときは終了ではないので別の通知にする。
+type jobReaper struct {
+	Lease       time.Duration
+	MaxRequeues int
+	Queue       *jobQueue
+}
+
+func (r *jobReaper) Run(ctx context.Context) {
+	interval := r.Lease / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := r.reap(); err != nil {
+			log.Printf("[WARN] reap stalled jobs: %v", err)
+		}
+	}
+}
+
+func (r *jobReaper) reap() error {
+	var jobIDs []int64
+	err := db.Select(
+		&jobIDs,
+		"SELECT `id` FROM `benchmark_jobs` WHERE `status` IN (?, ?) AND `updated_at` < TIMESTAMPADD(MICROSECOND, ?, NOW(6))",
+		resources.BenchmarkJob_SENT,
+		resources.BenchmarkJob_RUNNING,
+		-r.Lease.Microseconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("select stalled jobs: %w", err)
+	}
+	// 1 件の失敗で残りのジョブの回収を止めないように、ジョブごとのエラーはログに出して続ける
+	for _, jobID := range jobIDs {
+		if err := r.reapJob(jobID); err != nil {
+			log.Printf("[WARN] reap job(id=%v): %v", jobID, err)
+		}
+	}
+	return nil
+}
+
+func (r *jobReaper) reapJob(jobID int64) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 別のレプリカが先に回収したか、報告が届いていれば何もしない
+	var job xsuportal.BenchmarkJob
+	err = tx.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? AND `status` IN (?, ?) AND `updated_at` < TIMESTAMPADD(MICROSECOND, ?, NOW(6)) LIMIT 1 FOR UPDATE",
+		jobID,
+		resources.BenchmarkJob_SENT,
+		resources.BenchmarkJob_RUNNING,
+		-r.Lease.Microseconds(),
+	)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get benchmark job with lock: %w", err)
+	}
+
+	if job.RequeueCount < r.MaxRequeues {
+		handle, err := newJobHandle()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(
+			"UPDATE `benchmark_jobs` SET `status` = ?, `handle` = ?, `requeue_count` = `requeue_count` + 1, `score_raw` = NULL, `score_deduction` = NULL, `passed` = NULL, `reason` = NULL, `started_at` = NULL, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+			resources.BenchmarkJob_PENDING,
+			handle,
+			job.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("requeue benchmark job: %w", err)
+		}
+		_, err = tx.Exec(
+			"DELETE FROM `benchmark_job_progresses` WHERE `benchmark_job_id` = ?",
+			job.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("delete benchmark job progresses: %w", err)
+		}
+		_, err = tx.Exec(
+			"DELETE FROM `benchmark_job_reports` WHERE `benchmark_job_id` = ?",
+			job.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("delete benchmark job reports: %w", err)
+		}
+		log.Printf("[INFO] Requeued stalled job: job_id=%v, requeue_count=%v", job.ID, job.RequeueCount+1)
+		if err := notifier.NotifyBenchmarkJobRequeued(tx, &job); err != nil {
+			return fmt.Errorf("notify benchmark job: %w", err)
+		}
+		defer r.Queue.Notify()
+	} else {
+		_, err = tx.Exec(
+			"UPDATE `benchmark_jobs` SET `status` = ?, `passed` = FALSE, `reason` = ?, `finished_at` = ?, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+			resources.BenchmarkJob_ERRORED,
+			fmt.Sprintf("No report from benchmarker within %s", r.Lease),
+			clock.Now(),
+			job.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("mark benchmark job as errored: %w", err)
+		}
+		log.Printf("[INFO] Marked stalled job as errored: job_id=%v", job.ID)
+		if err := notifier.NotifyBenchmarkJobFinished(tx, &job); err != nil {
+			return fmt.Errorf("notify benchmark job: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	notifier.Outbox().Wakeup()
+	return nil
+}
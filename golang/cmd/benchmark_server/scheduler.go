@@ -0,0 +1,124 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	xsuportal "github.com/isucon/isucon10-final/webapp/golang"
+	"github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+// schedulingPolicy は次に渡す PENDING のジョブを選ぶ。選ぶのは contestID のコンテストのジョブだけ。
+// teamID が 0 でなければそのチーム専用のベンチマーカーなので、どの方式でもそのチームのジョブを古い順に返す。
+// 方式の状態は全て DB から求めるので、ベンチマークサーバーが複数台あっても同じ順序になる。
+type schedulingPolicy interface {
+	Name() string
+	Next(db sqlx.Queryer, contestID int64, teamID int64) (*xsuportal.BenchmarkJob, error)
+}
+
+func newSchedulingPolicy(name string) (schedulingPolicy, error) {
+	switch name {
+	case "fifo":
+		return fifoPolicy{}, nil
+	case "round-robin":
+		return roundRobinPolicy{}, nil
+	case "least-recently-served":
+		return leastRecentlyServedPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown scheduling policy: %q", name)
+	}
+}
+
+// fifoPolicy は積まれた順に渡す
+type fifoPolicy struct{}
+
+func (fifoPolicy) Name() string {
+	return "fifo"
+}
+
+func (fifoPolicy) Next(db sqlx.Queryer, contestID int64, teamID int64) (*xsuportal.BenchmarkJob, error) {
+	if teamID != 0 {
+		return getPendingJob(db, "SELECT * FROM `benchmark_jobs` WHERE `contest_id` = ? AND `status` = ? AND `team_id` = ? ORDER BY `id` LIMIT 1", contestID, resources.BenchmarkJob_PENDING, teamID)
+	}
+	return getPendingJob(db, "SELECT * FROM `benchmark_jobs` WHERE `contest_id` = ? AND `status` = ? ORDER BY `id` LIMIT 1", contestID, resources.BenchmarkJob_PENDING)
+}
+
+// roundRobinPolicy は最後に渡したジョブのチームの次の ID のチームから順に渡す
+type roundRobinPolicy struct{}
+
+func (roundRobinPolicy) Name() string {
+	return "round-robin"
+}
+
+func (roundRobinPolicy) Next(db sqlx.Queryer, contestID int64, teamID int64) (*xsuportal.BenchmarkJob, error) {
+	if teamID != 0 {
+		return fifoPolicy{}.Next(db, contestID, teamID)
+	}
+	var lastTeamID int64
+	err := sqlx.Get(
+		db,
+		&lastTeamID,
+		"SELECT `team_id` FROM `benchmark_jobs` WHERE `contest_id` = ? AND `dispatched_at` IS NOT NULL ORDER BY `dispatched_at` DESC LIMIT 1",
+		contestID,
+	)
+	if err != sql.ErrNoRows && err != nil {
+		return nil, fmt.Errorf("get last served team: %w", err)
+	}
+	job, err := getPendingJob(db, "SELECT * FROM `benchmark_jobs` WHERE `contest_id` = ? AND `status` = ? AND `team_id` > ? ORDER BY `team_id`, `id` LIMIT 1", contestID, resources.BenchmarkJob_PENDING, lastTeamID)
+	if job != nil || err != nil {
+		return job, err
+	}
+	return getPendingJob(db, "SELECT * FROM `benchmark_jobs` WHERE `contest_id` = ? AND `status` = ? ORDER BY `team_id`, `id` LIMIT 1", contestID, resources.BenchmarkJob_PENDING)
+}
+
+// leastRecentlyServedPolicy は最後にジョブを渡されてから最も時間が経ったチームに渡す。一度も渡されていないチームが最優先。
+type leastRecentlyServedPolicy struct{}
+
+func (leastRecentlyServedPolicy) Name() string {
+	return "least-recently-served"
+}
+
+func (leastRecentlyServedPolicy) Next(db sqlx.Queryer, contestID int64, teamID int64) (*xsuportal.BenchmarkJob, error) {
+	if teamID != 0 {
+		return fifoPolicy{}.Next(db, contestID, teamID)
+	}
+	query := "SELECT\n" +
+		"  `pending_jobs`.*\n" +
+		"FROM\n" +
+		"  `benchmark_jobs` `pending_jobs`\n" +
+		"  LEFT JOIN (\n" +
+		"    SELECT\n" +
+		"      `team_id`,\n" +
+		"      MAX(`dispatched_at`) AS `last_dispatched_at`\n" +
+		"    FROM\n" +
+		"      `benchmark_jobs`\n" +
+		"    WHERE\n" +
+		"      `contest_id` = ?\n" +
+		"      AND `dispatched_at` IS NOT NULL\n" +
+		"    GROUP BY\n" +
+		"      `team_id`\n" +
+		"  ) `served` ON `served`.`team_id` = `pending_jobs`.`team_id`\n" +
+		"WHERE\n" +
+		"  `pending_jobs`.`contest_id` = ?\n" +
+		"  AND `pending_jobs`.`status` = ?\n" +
+		"ORDER BY\n" +
+		"  `served`.`last_dispatched_at` IS NOT NULL,\n" +
+		"  `served`.`last_dispatched_at`,\n" +
+		"  `pending_jobs`.`id`\n" +
+		"LIMIT 1"
+	return getPendingJob(db, query, contestID, contestID, resources.BenchmarkJob_PENDING)
+}
+
+func getPendingJob(db sqlx.Queryer, query string, args ...interface{}) (*xsuportal.BenchmarkJob, error) {
+	var job xsuportal.BenchmarkJob
+	err := sqlx.Get(db, &job, query, args...)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get benchmark job: %w", err)
+	}
+	return &job, nil
+}
@@ -75,7 +75,7 @@ func InsertNotification(db sqlx.Ext, notificationPB *resources.Notification, con
 	}
 	encodedMessage := base64.StdEncoding.EncodeToString(b)
 	res, err := db.Exec(
-		"INSERT INTO `notifications` (`contestant_id`, `encoded_message`, `read`, `created_at`, `updated_at`) VALUES (?, ?, FALSE, NOW(6), NOW(6))",
+		"INSERT INTO `notifications` (`contest_id`, `contestant_id`, `encoded_message`, `read`, `created_at`, `updated_at`) VALUES (COALESCE((SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1), 0), ?, ?, FALSE, NOW(6), NOW(6))",
 		contestantID,
 		encodedMessage,
 	)
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	xsuportal "github.com/isucon/isucon10-final/webapp/golang"
+	resourcespb "github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+const (
+	FixtureMembersPerTeam        = 3
+	FixtureJobsPerTeam           = 5
+	FixtureClarificationsPerTeam = 2
+	FixturePassword              = "fixture"
+)
+
+// fixtureSeeder はリハーサルやデモ用に、チーム・コンテスタント・質問・完了済みのジョブを作る。
+// コンテスタントの ID は fixture-<チーム番号>-<メンバー番号> で、パスワードはすべて FixturePassword になる。
+// 乱数の種は固定なので、同じ Teams なら毎回同じスコアになる。
+type fixtureSeeder struct {
+	Teams int
+}
+
+func (f *fixtureSeeder) Seed(db *sqlx.DB) error {
+	contestStatus, err := xsuportal.GetContestStatus(db, clock)
+	if err != nil {
+		return fmt.Errorf("get contest status: %w", err)
+	}
+	// ジョブはコンテスト開始から現在 (終了後なら終了時刻) までの間に終わったことにする
+	from, to := contestStatus.ContestStartsAt, contestStatus.ContestEndsAt
+	if contestStatus.CurrentTime.After(from) && contestStatus.CurrentTime.Before(to) {
+		to = contestStatus.CurrentTime
+	}
+	password, err := passwordHasher.Hash(FixturePassword)
+	if err != nil {
+		return fmt.Errorf("hash fixture password: %w", err)
+	}
+	random := rand.New(rand.NewSource(1))
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	for i := 1; i <= f.Teams; i++ {
+		if err := f.seedTeam(tx, contestStatus.ID, random, i, password, from, to); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+func (f *fixtureSeeder) seedTeam(tx *sqlx.Tx, contestID int64, random *rand.Rand, i int, password string, from time.Time, to time.Time) error {
+	res, err := tx.Exec(
+		"INSERT INTO `teams` (`contest_id`, `name`, `email_address`, `invite_token`, `created_at`) VALUES (?, ?, ?, ?, ?)",
+		contestID,
+		fmt.Sprintf("Fixture Team %03d", i),
+		fmt.Sprintf("fixture-%03d@example.com", i),
+		fmt.Sprintf("fixture-%03d", i),
+		from,
+	)
+	if err != nil {
+		return fmt.Errorf("insert fixture team: %w", err)
+	}
+	teamID, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("get inserted fixture team id: %w", err)
+	}
+	for j := 1; j <= FixtureMembersPerTeam; j++ {
+		contestantID := fmt.Sprintf("fixture-%03d-%d", i, j)
+		_, err := tx.Exec(
+			"INSERT INTO `contestants` (`id`, `password`, `team_id`, `name`, `student`, `staff`, `created_at`) VALUES (?, ?, ?, ?, ?, FALSE, ?)",
+			contestantID,
+			password,
+			teamID,
+			fmt.Sprintf("Fixture %03d-%d", i, j),
+			random.Intn(2) == 0,
+			from,
+		)
+		if err != nil {
+			return fmt.Errorf("insert fixture contestant: %w", err)
+		}
+		if j == 1 {
+			_, err := tx.Exec("UPDATE `teams` SET `leader_id` = ? WHERE `id` = ? LIMIT 1", contestantID, teamID)
+			if err != nil {
+				return fmt.Errorf("update fixture team leader: %w", err)
+			}
+		}
+	}
+
+	span := to.Sub(from)
+	for k := 0; k < FixtureJobsPerTeam; k++ {
+		finishedAt := from.Add(span * time.Duration(k+1) / time.Duration(FixtureJobsPerTeam+1)).Round(time.Microsecond)
+		startedAt := finishedAt.Add(-time.Minute)
+		raw := 1000 + random.Intn(10000)
+		deduction := random.Intn(raw / 10)
+		_, err := tx.Exec(
+			"INSERT INTO `benchmark_jobs` (`contest_id`, `team_id`, `status`, `target_hostname`, `score_raw`, `score_deduction`, `reason`, `passed`, `started_at`, `finished_at`, `dispatched_at`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?, TRUE, ?, ?, ?, ?, NOW(6))",
+			contestID,
+			teamID,
+			int(resourcespb.BenchmarkJob_FINISHED),
+			fmt.Sprintf("fixture-%03d.example.com", i),
+			raw,
+			deduction,
+			"fixture",
+			startedAt,
+			finishedAt,
+			startedAt,
+			startedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert fixture benchmark job: %w", err)
+		}
+	}
+
+	for k := 0; k < FixtureClarificationsPerTeam; k++ {
+		createdAt := from.Add(span * time.Duration(k+1) / time.Duration(FixtureClarificationsPerTeam+1)).Round(time.Microsecond)
+		// 最後の 1 件は未回答のまま残す
+		answered := k < FixtureClarificationsPerTeam-1
+		var answer, answeredAt, disclosed interface{}
+		if answered {
+			answer = fmt.Sprintf("Fixture answer %d", k+1)
+			answeredAt = createdAt.Add(time.Minute)
+			disclosed = k%2 == 1
+		}
+		_, err := tx.Exec(
+			"INSERT INTO `clarifications` (`contest_id`, `team_id`, `disclosed`, `question`, `answer`, `answered_at`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			contestID,
+			teamID,
+			disclosed,
+			fmt.Sprintf("Fixture question %d from team %03d", k+1, i),
+			answer,
+			answeredAt,
+			createdAt,
+			createdAt,
+		)
+		if err != nil {
+			return fmt.Errorf("insert fixture clarification: %w", err)
+		}
+	}
+	return nil
+}
@@ -3,21 +3,25 @@ package main
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
 	"crypto/subtle"
 	"database/sql"
 	"encoding/base64"
-	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"log"
+	"math"
 	"net/http"
+	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/golang/protobuf/proto"
-	"github.com/gorilla/sessions"
+	"github.com/gorilla/securecookie"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
@@ -40,34 +44,109 @@ import (
 
 const (
 	// TODO: これをあげることで負荷をあげられる、300でボーナス倍率が2倍になる
-	TeamCapacity               = 73
-	AdminID                    = "admin"
-	AdminPassword              = "admin"
-	DebugContestStatusFilePath = "/tmp/XSUPORTAL_CONTEST_STATUS"
-	MYSQL_ER_DUP_ENTRY         = 1062
-	SessionName                = "xsucon_session"
+	TeamCapacity       = 73
+	MYSQL_ER_DUP_ENTRY = 1062
+	DefaultContestName = "default"
+	SessionName        = "xsucon_session"
 
 	AudienceDashBoardCacheKey = "audience_dashboard"
+	AdminDashBoardCacheKey    = "admin_dashboard"
+
+	BenchmarkJobProgressStreamPollInterval = 2 * time.Second
+	BenchmarkJobsDefaultLimit              = 100
+	BenchmarkJobsMaxLimit                  = 500
+	NotificationStreamPollInterval         = 3 * time.Second
+	NotificationStreamKeepAlive            = 15 * time.Second
+	NotificationsDefaultLimit              = 100
+	NotificationsMaxLimit                  = 500
 )
 
 var db *sqlx.DB
+var sessionStore *xsuportal.SessionStore
+var passwordHasher = &xsuportal.PasswordHasher{}
+var dummyPasswordHash string
+var loginThrottle = &xsuportal.LoginThrottle{
+	Threshold:   5,
+	IPThreshold: 50,
+	BaseLockout: 30 * time.Second,
+	MaxLockout:  15 * time.Minute,
+	Window:      time.Hour,
+}
 var notifier xsuportal.Notifier
+var clock xsuportal.Clock = xsuportal.SystemClock{}
+var rehearsalClock *xsuportal.RehearsalClock
 var cacheStore = cache.New(900*time.Millisecond, 5*time.Minute)
 var dashboardGroup singleflight.Group
 
+// dashboardGeneration は resetDashboardCache のたびに増える。singleflight のキーに含め、古い世代の結果はキャッシュしない。
+var dashboardGeneration int64
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		db, _ = xsuportal.GetDB()
+		xsuportal.WaitDB(db)
+		if err := xsuportal.RunMigrateCommand(db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	srv := echo.New()
 	srv.Server.Addr = fmt.Sprintf(":%v", util.GetEnv("PORT", "9292"))
 	srv.HideBanner = true
 
 	srv.Binder = ProtoBinder{}
+	// 手前の envoy (同じホスト) が X-Forwarded-For の末尾に付けた接続元を使う。
+	// クライアントが送ってきた X-Forwarded-For は信用しないので、ループバック以外は信頼するプロキシとして扱わない。
+	srv.IPExtractor = echo.ExtractIPFromXFFHeader(echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
 
 	db, _ = xsuportal.GetDB()
 
 	xsuportal.WaitDB(db)
 	go xsuportal.PollDB(db)
 
-	srv.Use(session.Middleware(sessions.NewCookieStore([]byte("tagomoris"))))
+	if err := xsuportal.MigrateOnStart(db); err != nil {
+		panic(err)
+	}
+
+	if rehearsal, _ := strconv.ParseBool(util.GetEnv("XSUPORTAL_REHEARSAL", "false")); rehearsal {
+		rehearsalClock = &xsuportal.RehearsalClock{}
+		if err := rehearsalClock.Load(db); err != nil {
+			panic(err)
+		}
+		go rehearsalClock.Run(context.Background(), db)
+		clock = rehearsalClock
+	}
+	notifier.Clock = clock
+
+	notifierWorkers, _ := strconv.Atoi(util.GetEnv("NOTIFIER_WORKERS", "4"))
+	go notifier.Run(context.Background(), db, notifierWorkers)
+
+	retention, err := time.ParseDuration(util.GetEnv("NOTIFICATION_RETENTION", "24h"))
+	if err != nil {
+		panic(err)
+	}
+	if retention > 0 {
+		pruner := &notificationPruner{Retention: retention, Interval: time.Minute}
+		go pruner.Run(context.Background())
+	}
+
+	loginThrottle.Threshold, _ = strconv.Atoi(util.GetEnv("LOGIN_THROTTLE_THRESHOLD", "5"))
+	loginThrottle.IPThreshold, _ = strconv.Atoi(util.GetEnv("LOGIN_THROTTLE_IP_THRESHOLD", "50"))
+	passwordHasher.BcryptCost, _ = strconv.Atoi(util.GetEnv("PASSWORD_BCRYPT_COST", "0"))
+	dummyPasswordHash, err = passwordHasher.Hash("dummy")
+	if err != nil {
+		panic(err)
+	}
+
+	sessionMaxAge, err := time.ParseDuration(util.GetEnv("SESSION_MAX_AGE", "24h"))
+	if err != nil {
+		panic(err)
+	}
+	sessionStore = xsuportal.NewSessionStore(db, getSessionSecrets(), sessionMaxAge)
+	go sessionStore.Run(context.Background())
+	srv.Use(session.Middleware(sessionStore))
+	srv.Use(touchSession)
 
 	srv.File("/", "public/audience.html")
 	srv.File("/registration", "public/audience.html")
@@ -94,10 +173,43 @@ func main() {
 	contestant := &ContestantService{}
 	common := &CommonService{}
 
-	srv.POST("/initialize", admin.Initialize)
-	srv.GET("/api/admin/clarifications", admin.ListClarifications)
-	srv.GET("/api/admin/clarifications/:id", admin.GetClarification)
-	srv.PUT("/api/admin/clarifications/:id", admin.RespondClarification)
+	if util.GetEnv("INITIALIZE_TOKEN", "") == "" {
+		log.Print("[WARN] INITIALIZE_TOKEN is not set: only contest operators can call /initialize")
+	}
+	srv.POST("/initialize", admin.Initialize, initializeAllowed)
+	viewer := staffRoleRequired(xsuportal.StaffRoleViewer)
+	answerer := staffRoleRequired(xsuportal.StaffRoleClarificationAnswerer)
+	operator := staffRoleRequired(xsuportal.StaffRoleContestOperator)
+	adminAPI := srv.Group("/api/admin", staffRequired)
+	adminAPI.GET("/dashboard", admin.Dashboard, viewer)
+	adminAPI.GET("/contest", admin.GetContest, viewer)
+	adminAPI.PUT("/contest", admin.UpdateContest, operator)
+	adminAPI.GET("/contests", admin.ListContests, viewer)
+	adminAPI.POST("/contests", admin.CreateContest, operator)
+	adminAPI.POST("/contests/:id/activate", admin.ActivateContest, operator)
+	adminAPI.POST("/contests/:id/archive", admin.ArchiveContest, operator)
+	adminAPI.GET("/clarifications", admin.ListClarifications, viewer)
+	adminAPI.POST("/clarifications", admin.CreateClarification, answerer)
+	adminAPI.GET("/clarifications/:id", admin.GetClarification, viewer)
+	adminAPI.PUT("/clarifications/:id", admin.RespondClarification, answerer)
+	adminAPI.GET("/teams", admin.ListTeams, viewer)
+	adminAPI.GET("/teams/:id", admin.GetTeam, viewer)
+	adminAPI.PUT("/teams/:id", admin.UpdateTeam, operator)
+	adminAPI.GET("/benchmark_jobs", admin.ListBenchmarkJobs, viewer)
+	adminAPI.POST("/benchmark_jobs", admin.EnqueueBenchmarkJob, operator)
+	adminAPI.GET("/benchmark_jobs/:id", admin.GetBenchmarkJob, viewer)
+	adminAPI.POST("/benchmark_jobs/:id/cancel", admin.CancelBenchmarkJob, operator)
+	adminAPI.GET("/benchmark_queue_waits", admin.ListBenchmarkQueueWaits, viewer)
+	adminAPI.GET("/contestants/:id/sessions", admin.ListContestantSessions, viewer)
+	adminAPI.DELETE("/contestants/:id/sessions", admin.RevokeContestantSessions, operator)
+	adminAPI.DELETE("/contestants/:id/sessions/:session_id", admin.RevokeContestantSessions, operator)
+	adminAPI.GET("/login_attempts", admin.ListLoginAttempts, viewer)
+	adminAPI.DELETE("/login_attempts/:scope/:key", admin.ClearLoginAttempts, operator)
+	adminAPI.GET("/staffs", admin.ListStaffs, viewer)
+	adminAPI.PUT("/staffs/:contestant_id", admin.UpdateStaff, operator)
+	adminAPI.DELETE("/staffs/:contestant_id", admin.DeleteStaff, operator)
+	adminAPI.GET("/clock", admin.GetClock, viewer)
+	adminAPI.PUT("/clock", admin.UpdateClock, operator)
 	srv.GET("/api/session", common.GetCurrentSession)
 	srv.GET("/api/audience/teams", audience.ListTeams)
 	srv.GET("/api/audience/dashboard", audience.Dashboard)
@@ -109,10 +221,15 @@ func main() {
 	srv.POST("/api/contestant/benchmark_jobs", contestant.EnqueueBenchmarkJob)
 	srv.GET("/api/contestant/benchmark_jobs", contestant.ListBenchmarkJobs)
 	srv.GET("/api/contestant/benchmark_jobs/:id", contestant.GetBenchmarkJob)
+	srv.GET("/api/contestant/benchmark_jobs/:id/progress", contestant.StreamBenchmarkJobProgress)
 	srv.GET("/api/contestant/clarifications", contestant.ListClarifications)
 	srv.POST("/api/contestant/clarifications", contestant.RequestClarification)
 	srv.GET("/api/contestant/dashboard", contestant.Dashboard)
 	srv.GET("/api/contestant/notifications", contestant.ListNotifications)
+	srv.GET("/api/contestant/notifications/stream", contestant.StreamNotifications)
+	srv.POST("/api/contestant/notifications/read", contestant.MarkNotificationsRead)
+	srv.GET("/api/contestant/notification_preferences", contestant.GetNotificationPreferences)
+	srv.PUT("/api/contestant/notification_preferences", contestant.UpdateNotificationPreferences)
 	srv.POST("/api/contestant/push_subscriptions", contestant.SubscribeNotification)
 	srv.DELETE("/api/contestant/push_subscriptions", contestant.UnsubscribeNotification)
 	srv.POST("/api/signup", contestant.Signup)
@@ -140,55 +257,139 @@ func (p ProtoBinder) Bind(i interface{}, e echo.Context) error {
 
 type AdminService struct{}
 
+// Initialize はアクティブなコンテストのデータを消して初期状態に戻す。アーカイブ済みのコンテストとそのチームのメンバーは残す。
+// コンテスト開始後は ?force=true を付けないと 409 を返す。?fixture_teams=N を付けると N チーム分のデータを入れる。
 func (*AdminService) Initialize(e echo.Context) error {
 	var req adminpb.InitializeRequest
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
+	force, _ := strconv.ParseBool(e.QueryParam("force"))
+	fixtureTeams := 0
+	if v := e.QueryParam("fixture_teams"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 || n > TeamCapacity {
+			return halt(e, http.StatusBadRequest, fmt.Sprintf("fixture_teams は 0 から %d までにしてください", TeamCapacity), nil)
+		}
+		fixtureTeams = n
+	}
+	if !force {
+		contestStatus, err := xsuportal.GetContestStatus(db, clock)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("get contest status: %w", err)
+		}
+		if err == nil && !contestStatus.CurrentTime.Before(contestStatus.ContestStartsAt) {
+			return halt(e, http.StatusConflict, "コンテスト開始後に初期化するには force=true を指定してください", nil)
+		}
+	}
+	log.Printf("[INFO] initialize (force=%v, fixture_teams=%d)", force, fixtureTeams)
 
+	// アーカイブ済みのコンテストの記録は残し、アクティブなコンテストのデータだけを消す
+	var activeContestID int64
+	err := db.Get(&activeContestID, "SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1")
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("get active contest: %w", err)
+	}
+	contestQueries := []string{
+		"DELETE `benchmark_job_progresses` FROM `benchmark_job_progresses` INNER JOIN `benchmark_jobs` ON `benchmark_jobs`.`id` = `benchmark_job_progresses`.`benchmark_job_id` WHERE `benchmark_jobs`.`contest_id` = ?",
+		"DELETE `benchmark_job_reports` FROM `benchmark_job_reports` INNER JOIN `benchmark_jobs` ON `benchmark_jobs`.`id` = `benchmark_job_reports`.`benchmark_job_id` WHERE `benchmark_jobs`.`contest_id` = ?",
+		"DELETE FROM `benchmark_jobs` WHERE `contest_id` = ?",
+		"DELETE FROM `clarifications` WHERE `contest_id` = ?",
+		"DELETE FROM `notifications` WHERE `contest_id` = ?",
+		"DELETE FROM `teams` WHERE `contest_id` = ?",
+	}
+	for _, query := range contestQueries {
+		_, err := db.Exec(query, activeContestID)
+		if err != nil {
+			return fmt.Errorf("delete active contest data: %w", err)
+		}
+	}
+	// コンテスタントはコンテストをまたぐので、アーカイブ済みのコンテストのチームのメンバーだけを残す
 	queries := []string{
-		"TRUNCATE `teams`",
-		"TRUNCATE `contestants`",
-		"TRUNCATE `benchmark_jobs`",
-		"TRUNCATE `clarifications`",
-		"TRUNCATE `notifications`",
-		"TRUNCATE `push_subscriptions`",
-		"TRUNCATE `contest_config`",
+		"DELETE FROM `contestants` WHERE `team_id` IS NULL OR `team_id` NOT IN (SELECT `id` FROM `teams`)",
+		"DELETE FROM `notifications` WHERE `contestant_id` NOT IN (SELECT `id` FROM `contestants`)",
+		"DELETE FROM `push_subscriptions` WHERE `contestant_id` NOT IN (SELECT `id` FROM `contestants`)",
+		"DELETE FROM `notification_preferences` WHERE `contestant_id` NOT IN (SELECT `id` FROM `contestants`)",
+		"DELETE FROM `staffs` WHERE `contestant_id` NOT IN (SELECT `id` FROM `contestants`)",
+		"TRUNCATE `sessions`",
+		"TRUNCATE `login_attempts`",
+		"TRUNCATE `notification_outbox`",
 	}
 	for _, query := range queries {
 		_, err := db.Exec(query)
 		if err != nil {
-			return fmt.Errorf("truncate table: %w", err)
+			return fmt.Errorf("delete contestant data: %w", err)
 		}
 	}
 
-	passwordHash := sha256.Sum256([]byte(AdminPassword))
-	digest := hex.EncodeToString(passwordHash[:])
-	_, err := db.Exec("INSERT `contestants` (`id`, `password`, `staff`, `created_at`) VALUES (?, ?, TRUE, NOW(6))", AdminID, digest)
+	adminID := util.GetEnv("ADMIN_ID", "admin")
+	// ADMIN_PASSWORD がなければ推測できないパスワードを作り、ログには出さずにレスポンスでだけ返す
+	adminPlainPassword := util.GetEnv("ADMIN_PASSWORD", "")
+	generatedAdminPassword := ""
+	if adminPlainPassword == "" {
+		randomBytes := make([]byte, 24)
+		if _, err := rand.Read(randomBytes); err != nil {
+			return fmt.Errorf("read random: %w", err)
+		}
+		adminPlainPassword = base64.RawURLEncoding.EncodeToString(randomBytes)
+		generatedAdminPassword = adminPlainPassword
+		log.Printf("[WARN] ADMIN_PASSWORD is not set: generated a password for %s and returned it in the response", adminID)
+	}
+	adminPassword, err := passwordHasher.Hash(adminPlainPassword)
+	if err != nil {
+		return fmt.Errorf("hash admin password: %w", err)
+	}
+	_, err = db.Exec("INSERT `contestants` (`id`, `password`, `staff`, `created_at`) VALUES (?, ?, TRUE, NOW(6))", adminID, adminPassword)
 	if err != nil {
 		return fmt.Errorf("insert initial contestant: %w", err)
 	}
+	_, err = db.Exec("INSERT `staffs` (`contestant_id`, `role`, `created_at`, `updated_at`) VALUES (?, ?, NOW(6), NOW(6))", adminID, xsuportal.StaffRoleContestOperator)
+	if err != nil {
+		return fmt.Errorf("insert initial staff: %w", err)
+	}
 
+	now := clock.Now().Round(time.Microsecond)
+	registrationOpenAt, contestStartsAt, contestFreezesAt, contestEndsAt := now, now.Add(5*time.Second), now.Add(40*time.Second), now.Add(50*time.Second)
 	if req.Contest != nil {
+		registrationOpenAt = req.Contest.RegistrationOpenAt.AsTime().Round(time.Microsecond)
+		contestStartsAt = req.Contest.ContestStartsAt.AsTime().Round(time.Microsecond)
+		contestFreezesAt = req.Contest.ContestFreezesAt.AsTime().Round(time.Microsecond)
+		contestEndsAt = req.Contest.ContestEndsAt.AsTime().Round(time.Microsecond)
+	}
+	if activeContestID != 0 {
 		_, err := db.Exec(
-			"INSERT `contest_config` (`registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`) VALUES (?, ?, ?, ?)",
-			req.Contest.RegistrationOpenAt.AsTime().Round(time.Microsecond),
-			req.Contest.ContestStartsAt.AsTime().Round(time.Microsecond),
-			req.Contest.ContestFreezesAt.AsTime().Round(time.Microsecond),
-			req.Contest.ContestEndsAt.AsTime().Round(time.Microsecond),
+			"UPDATE `contests` SET `registration_open_at` = ?, `contest_starts_at` = ?, `contest_freezes_at` = ?, `contest_ends_at` = ?, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+			registrationOpenAt,
+			contestStartsAt,
+			contestFreezesAt,
+			contestEndsAt,
+			activeContestID,
 		)
 		if err != nil {
-			return fmt.Errorf("insert contest: %w", err)
+			return fmt.Errorf("update contest: %w", err)
 		}
 	} else {
-		_, err := db.Exec("INSERT `contest_config` (`registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`) VALUES (TIMESTAMPADD(SECOND, 0, NOW(6)), TIMESTAMPADD(SECOND, 5, NOW(6)), TIMESTAMPADD(SECOND, 40, NOW(6)), TIMESTAMPADD(SECOND, 50, NOW(6)))")
+		_, err := db.Exec(
+			"INSERT `contests` (`name`, `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`, `active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, TRUE, NOW(6), NOW(6))",
+			DefaultContestName,
+			registrationOpenAt,
+			contestStartsAt,
+			contestFreezesAt,
+			contestEndsAt,
+		)
 		if err != nil {
 			return fmt.Errorf("insert contest: %w", err)
 		}
 	}
 
-	cacheStore.Flush()
-	dashboardGroup = singleflight.Group{}
+	if fixtureTeams > 0 {
+		seeder := &fixtureSeeder{Teams: fixtureTeams}
+		if err := seeder.Seed(db); err != nil {
+			return fmt.Errorf("seed fixtures: %w", err)
+		}
+	}
+
+	resetDashboardCache()
 
 	host := util.GetEnv("BENCHMARK_SERVER_HOST", "localhost")
 	port, _ := strconv.Atoi(util.GetEnv("BENCHMARK_SERVER_PORT", "50051"))
@@ -198,10 +399,18 @@ func (*AdminService) Initialize(e echo.Context) error {
 			Host: host,
 			Port: int64(port),
 		},
+		AdminPassword: generatedAdminPassword,
 	}
 	return writeProto(e, http.StatusOK, res)
 }
 
+// resetDashboardCache はキャッシュ済みのダッシュボードを捨て、実行中の singleflight の結果を使わないようにする。
+// コンテストの設定が変わって凍結の有無などが変わりうるときや、チームの情報を書き換えたときに呼ぶ。
+func resetDashboardCache() {
+	atomic.AddInt64(&dashboardGeneration, 1)
+	cacheStore.Flush()
+}
+
 func getTeams(teamIDs []int64) (map[int64]xsuportal.Team, error) {
 	sql, params, err  := sqlx.In(
 		"SELECT * FROM `teams` WHERE `id` IN (?)",
@@ -224,20 +433,123 @@ func getTeams(teamIDs []int64) (map[int64]xsuportal.Team, error) {
 	return teamMap, err
 }
 
-func (*AdminService) ListClarifications(e echo.Context) error {
-	contestant, err := getCurrentContestant(e, db, false)
+func (*AdminService) Dashboard(e echo.Context) error {
+	// 凍結中の順位を含むので、ブラウザや中間のキャッシュには載せない
+	e.Response().Header().Set("Cache-Control", "private, no-store")
+	if c, ok := cacheStore.Get(AdminDashBoardCacheKey); ok {
+		return e.Blob(http.StatusOK, "application/vnd.google.protobuf", c.([]byte))
+	}
+	res, err := makeLeaderboardPB(e, 0, true)
 	if err != nil {
-		return wrapError("check session", err)
+		return fmt.Errorf("make leaderboard: %w", err)
 	}
-	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{}); !ok {
-		return wrapError("check session", err)
+	return e.Blob(http.StatusOK, "application/vnd.google.protobuf", res)
+}
+
+func (*AdminService) GetContest(e echo.Context) error {
+	contest, err := makeContestPB(e)
+	if err != nil {
+		return fmt.Errorf("make contest: %w", err)
+	}
+	return writeProto(e, http.StatusOK, contest)
+}
+
+// UpdateContest はデータを消さずにコンテストのスケジュールだけを変更し、全コンテスタントにお知らせする。
+// registration_open_at < contest_starts_at <= contest_freezes_at <= contest_ends_at でなければならない。
+func (*AdminService) UpdateContest(e echo.Context) error {
+	var req resourcespb.Contest
+	if err := e.Bind(&req); err != nil {
+		return err
 	}
+	if req.RegistrationOpenAt == nil || req.ContestStartsAt == nil || req.ContestFreezesAt == nil || req.ContestEndsAt == nil {
+		return halt(e, http.StatusBadRequest, "全ての日時を指定してください", nil)
+	}
+	registrationOpenAt := req.RegistrationOpenAt.AsTime().Round(time.Microsecond)
+	contestStartsAt := req.ContestStartsAt.AsTime().Round(time.Microsecond)
+	contestFreezesAt := req.ContestFreezesAt.AsTime().Round(time.Microsecond)
+	contestEndsAt := req.ContestEndsAt.AsTime().Round(time.Microsecond)
+	if message := checkContestSchedule(registrationOpenAt, contestStartsAt, contestFreezesAt, contestEndsAt); message != "" {
+		return halt(e, http.StatusBadRequest, message, nil)
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	var before xsuportal.Contest
+	err = tx.Get(&before, "SELECT * FROM `contests` WHERE `active` = TRUE LIMIT 1 FOR UPDATE")
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "アクティブなコンテストがありません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get active contest: %w", err)
+	}
+	if before.RegistrationOpenAt.Equal(registrationOpenAt) && before.ContestStartsAt.Equal(contestStartsAt) && before.ContestFreezesAt.Equal(contestFreezesAt) && before.ContestEndsAt.Equal(contestEndsAt) {
+		// 日時が変わっていなければお知らせも不要
+		contest, err := makeContestPB(e)
+		if err != nil {
+			return fmt.Errorf("make contest: %w", err)
+		}
+		return writeProto(e, http.StatusOK, contest)
+	}
+	_, err = tx.Exec(
+		"UPDATE `contests` SET `registration_open_at` = ?, `contest_starts_at` = ?, `contest_freezes_at` = ?, `contest_ends_at` = ?, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+		registrationOpenAt,
+		contestStartsAt,
+		contestFreezesAt,
+		contestEndsAt,
+		before.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update contest: %w", err)
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	const layout = "2006-01-02 15:04:05 MST"
+	answer := fmt.Sprintf(
+		"コンテストのスケジュールが変更されました。\n登録開始: %s\n競技開始: %s\nスコア凍結: %s\n競技終了: %s",
+		registrationOpenAt.In(jst).Format(layout),
+		contestStartsAt.In(jst).Format(layout),
+		contestFreezesAt.In(jst).Format(layout),
+		contestEndsAt.In(jst).Format(layout),
+	)
+	if _, err := createAnnouncement(tx, before.ID, 0, "コンテストのスケジュール変更", answer); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	notifier.Outbox().Wakeup()
+	resetDashboardCache()
+	getXsuportalContext(e).ContestStatus = nil
+
+	contest, err := makeContestPB(e)
+	if err != nil {
+		return fmt.Errorf("make contest: %w", err)
+	}
+	return writeProto(e, http.StatusOK, contest)
+}
+
+// checkContestSchedule は日時の順序がおかしければエラーメッセージを返す。
+func checkContestSchedule(registrationOpenAt, contestStartsAt, contestFreezesAt, contestEndsAt time.Time) string {
+	switch {
+	case !registrationOpenAt.Before(contestStartsAt):
+		return "registration_open_at は contest_starts_at より前にしてください"
+	case contestFreezesAt.Before(contestStartsAt):
+		return "contest_freezes_at は contest_starts_at 以降にしてください"
+	case contestEndsAt.Before(contestFreezesAt):
+		return "contest_ends_at は contest_freezes_at 以降にしてください"
+	}
+	return ""
+}
 
-	if !contestant.Staff {
-		return halt(e, http.StatusForbidden, "管理者権限が必要です", nil)
+func (*AdminService) ListClarifications(e echo.Context) error {
+	contestID, err := getAdminContestID(e)
+	if err != nil {
+		return err
 	}
 	var clarifications []xsuportal.Clarification
-	err = db.Select(&clarifications, "SELECT * FROM `clarifications` ORDER BY `updated_at` DESC")
+	err = db.Select(&clarifications, "SELECT * FROM `clarifications` WHERE `contest_id` = ? ORDER BY `updated_at` DESC", contestID)
 	if err != sql.ErrNoRows && err != nil {
 		return fmt.Errorf("query clarifications: %w", err)
 	}
@@ -266,22 +578,96 @@ func (*AdminService) ListClarifications(e echo.Context) error {
 	return writeProto(e, http.StatusOK, res)
 }
 
-func (*AdminService) GetClarification(e echo.Context) error {
-	contestant, err := getCurrentContestant(e, db, false)
+// INFO: 運営からのお知らせとして、回答済みの質問を作成する。team_id = 0 なら全チームに公開し、指定があればそのチームにだけ送る。
+func (*AdminService) CreateClarification(e echo.Context) error {
+	var req adminpb.CreateClarificationRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	if req.Answer == "" {
+		return halt(e, http.StatusBadRequest, "回答が必要です", nil)
+	}
+
+	tx, err := db.Beginx()
 	if err != nil {
-		return wrapError("check session", err)
+		return fmt.Errorf("begin tx: %w", err)
 	}
-	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{}); !ok {
-		return wrapError("check session", err)
+	defer tx.Rollback()
+
+	contestStatus, err := getCurrentContestStatus(e, tx)
+	if err != nil {
+		return fmt.Errorf("get current contest status: %w", err)
+	}
+	var team xsuportal.Team
+	if req.TeamId != 0 {
+		err := tx.Get(
+			&team,
+			"SELECT * FROM `teams` WHERE `id` = ? AND `contest_id` = ? LIMIT 1",
+			req.TeamId,
+			contestStatus.ID,
+		)
+		if err == sql.ErrNoRows {
+			return halt(e, http.StatusNotFound, "チームが見つかりません", nil)
+		}
+		if err != nil {
+			return fmt.Errorf("get team: %w", err)
+		}
+	}
+	clarification, err := createAnnouncement(tx, contestStatus.ID, req.TeamId, req.Question, req.Answer)
+	if err != nil {
+		return err
+	}
+	c, err := makeClarificationPB(tx, clarification, &team)
+	if err != nil {
+		return fmt.Errorf("make clarification: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	notifier.Outbox().Wakeup()
+	return writeProto(e, http.StatusOK, &adminpb.CreateClarificationResponse{
+		Clarification: c,
+	})
+}
+
+// createAnnouncement は回答済みの質問を作って通知を積む。teamID が 0 ならコンテストの全チームに公開する。
+func createAnnouncement(tx *sqlx.Tx, contestID int64, teamID int64, question string, answer string) (*xsuportal.Clarification, error) {
+	now := clock.Now()
+	res, err := tx.Exec(
+		"INSERT INTO `clarifications` (`contest_id`, `team_id`, `disclosed`, `question`, `answer`, `answered_at`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		contestID,
+		teamID,
+		teamID == 0,
+		question,
+		answer,
+		now,
+		now,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("insert clarification: %w", err)
+	}
+	id, _ := res.LastInsertId()
+	var clarification xsuportal.Clarification
+	err = tx.Get(
+		&clarification,
+		"SELECT * FROM `clarifications` WHERE `id` = ? LIMIT 1",
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get clarification: %w", err)
 	}
+	if err := notifier.NotifyClarificationAnswered(tx, &clarification, false); err != nil {
+		return nil, fmt.Errorf("notify clarification answered: %w", err)
+	}
+	return &clarification, nil
+}
 
+func (*AdminService) GetClarification(e echo.Context) error {
 	id, err := strconv.Atoi(e.Param("id"))
 	if err != nil {
 		return fmt.Errorf("parse id: %w", err)
 	}
-	if !contestant.Staff {
-		return halt(e, http.StatusForbidden, "管理者権限が必要です", nil)
-	}
 	var clarification xsuportal.Clarification
 	err = db.Get(
 		&clarification,
@@ -291,13 +677,14 @@ func (*AdminService) GetClarification(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("get clarification: %w", err)
 	}
+	// 全チーム向けのお知らせは team_id = 0 で、チームを持たない
 	var team xsuportal.Team
 	err = db.Get(
 		&team,
 		"SELECT * FROM `teams` WHERE id = ? LIMIT 1",
 		clarification.TeamID,
 	)
-	if err != nil {
+	if err != sql.ErrNoRows && err != nil {
 		return fmt.Errorf("get team: %w", err)
 	}
 	c, err := makeClarificationPB(db, &clarification, &team)
@@ -310,21 +697,10 @@ func (*AdminService) GetClarification(e echo.Context) error {
 }
 
 func (*AdminService) RespondClarification(e echo.Context) error {
-	contestant, err := getCurrentContestant(e, db, false)
-	if err != nil {
-		return wrapError("check session", err)
-	}
-	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{}); !ok {
-		return wrapError("check session", err)
-	}
-
 	id, err := strconv.Atoi(e.Param("id"))
 	if err != nil {
 		return fmt.Errorf("parse id: %w", err)
 	}
-	if !contestant.Staff {
-		return halt(e, http.StatusForbidden, "管理者権限が必要です", nil)
-	}
 	var req adminpb.RespondClarificationRequest
 	if err := e.Bind(&req); err != nil {
 		return err
@@ -348,13 +724,19 @@ func (*AdminService) RespondClarification(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("get clarification with lock: %w", err)
 	}
+	if ok, err := contestWritable(e, tx, clarificationBefore.ContestID); !ok {
+		return wrapError("check contest", err)
+	}
 	wasAnswered := clarificationBefore.AnsweredAt.Valid
 	wasDisclosed := clarificationBefore.Disclosed
 
+	now := clock.Now()
 	_, err = tx.Exec(
-		"UPDATE `clarifications` SET `disclosed` = ?, `answer` = ?, `updated_at` = NOW(6), `answered_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+		"UPDATE `clarifications` SET `disclosed` = ?, `answer` = ?, `updated_at` = ?, `answered_at` = ? WHERE `id` = ? LIMIT 1",
 		req.Disclose,
 		req.Answer,
+		now,
+		now,
 		id,
 	)
 	if err != nil {
@@ -376,90 +758,964 @@ func (*AdminService) RespondClarification(e echo.Context) error {
 		"SELECT * FROM `teams` WHERE `id` = ? LIMIT 1",
 		clarification.TeamID,
 	)
-	if err != nil {
+	if err != sql.ErrNoRows && err != nil {
 		return fmt.Errorf("get team: %w", err)
 	}
 	c, err := makeClarificationPB(tx, &clarification, &team)
 	if err != nil {
 		return fmt.Errorf("make clarification: %w", err)
 	}
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit tx: %w", err)
-	}
 	updated := wasAnswered && wasDisclosed == clarification.Disclosed
-	if err := notifier.NotifyClarificationAnswered(db, &clarification, updated); err != nil {
+	if err := notifier.NotifyClarificationAnswered(tx, &clarification, updated); err != nil {
 		return fmt.Errorf("notify clarification answered: %w", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	notifier.Outbox().Wakeup()
 	return writeProto(e, http.StatusOK, &adminpb.RespondClarificationResponse{
 		Clarification: c,
 	})
 }
 
-type CommonService struct{}
-
-func (*CommonService) GetCurrentSession(e echo.Context) error {
-	res := &commonpb.GetCurrentSessionResponse{}
-	currentContestant, err := getCurrentContestant(e, db, false)
+func (*AdminService) ListTeams(e echo.Context) error {
+	contestID, err := getAdminContestID(e)
 	if err != nil {
-		return fmt.Errorf("get current contestant: %w", err)
+		return err
 	}
-	if currentContestant != nil {
-		res.Contestant = makeContestantPB(currentContestant)
+	var teams []xsuportal.Team
+	err = db.Select(&teams, "SELECT * FROM `teams` WHERE `contest_id` = ? ORDER BY `id`", contestID)
+	if err != nil {
+		return fmt.Errorf("select teams: %w", err)
 	}
-	// TODO: 中でgetCurrentContestantが呼ばれる
-	currentTeam, err := getCurrentTeam(e, db, false)
+	var members []xsuportal.Contestant
+	err = db.Select(&members, "SELECT `contestants`.* FROM `contestants` INNER JOIN `teams` ON `teams`.`id` = `contestants`.`team_id` WHERE `teams`.`contest_id` = ? ORDER BY `contestants`.`created_at`", contestID)
 	if err != nil {
-		return fmt.Errorf("get current team: %w", err)
+		return fmt.Errorf("select members: %w", err)
 	}
-	if currentTeam != nil {
-		res.Team, err = makeTeamPB(db, currentTeam, true, true)
-		if err != nil {
-			return fmt.Errorf("make team: %w", err)
+	teamMembers := make(map[int64][]xsuportal.Contestant)
+	for _, member := range members {
+		teamMembers[member.TeamID.Int64] = append(teamMembers[member.TeamID.Int64], member)
+	}
+
+	res := &adminpb.ListTeamsResponse{}
+	for _, team := range teams {
+		var memberNames []string
+		isStudent := true
+		for _, member := range teamMembers[team.ID] {
+			memberNames = append(memberNames, member.Name.String)
+			isStudent = isStudent && member.Student
 		}
+		res.Teams = append(res.Teams, &adminpb.ListTeamsResponse_TeamListItem{
+			TeamId:      team.ID,
+			Name:        team.Name,
+			MemberNames: memberNames,
+			IsStudent:   isStudent,
+			Withdrawn:   team.Withdrawn,
+		})
 	}
-	res.Contest, err = makeContestPB(e)
+	return writeProto(e, http.StatusOK, res)
+}
+
+func (*AdminService) GetTeam(e echo.Context) error {
+	id, err := strconv.Atoi(e.Param("id"))
 	if err != nil {
-		return fmt.Errorf("make contest: %w", err)
+		return fmt.Errorf("parse id: %w", err)
 	}
-	vapidKey := notifier.VAPIDKey()
-	if vapidKey != nil {
-		res.PushVapidKey = vapidKey.VAPIDPublicKey
+	var team xsuportal.Team
+	err = db.Get(
+		&team,
+		"SELECT * FROM `teams` WHERE `id` = ? LIMIT 1",
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "チームが見つかりません", nil)
 	}
-	return writeProto(e, http.StatusOK, res)
+	if err != nil {
+		return fmt.Errorf("get team: %w", err)
+	}
+	t, err := makeTeamPB(db, &team, true, true)
+	if err != nil {
+		return fmt.Errorf("make team: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.GetTeamResponse{
+		Team: t,
+	})
 }
 
-type ContestantService struct{}
-
-/*
-	INFO:
-	仮想選手は、エンキューができる状況にあるときは即時にエンキューを試みます。以下のような状況にある場合は仮想選手はエンキューをせず、状況が変化するのを待ちます。
-	- まだ FINISHED になっていない所属仮想チームのジョブ（PENDING, SENT, RUNNING）がすでにあり、まだ仮想負荷走行終了の通知を受け取っていないとき
-	- 仮想選手本人あるいは所属仮想チームのメンバーが仮想運営への質問（Clarification）を投稿したあと、まだ仮想運営からの回答を確認していないとき
-*/
-func (*ContestantService) EnqueueBenchmarkJob(e echo.Context) error {
-	var req contestantpb.EnqueueBenchmarkJobRequest
+// INFO: チームとメンバーの更新は 1 トランザクションで行い、人数上限やリーダーの所属が不正なら全てロールバックする
+func (*AdminService) UpdateTeam(e echo.Context) error {
+	id, err := strconv.Atoi(e.Param("id"))
+	if err != nil {
+		return fmt.Errorf("parse id: %w", err)
+	}
+	var req adminpb.UpdateTeamRequest
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
+	if req.Team == nil {
+		return halt(e, http.StatusBadRequest, "チームの指定が必要です", nil)
+	}
+
 	tx, err := db.Beginx()
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback()
-	// TODO: 中でgetCurrentContestantが呼ばれる
-	if ok, err := loginRequired(e, tx, &loginRequiredOption{Team: true}); !ok {
-		return wrapError("check session", err)
+
+	var team xsuportal.Team
+	err = tx.Get(
+		&team,
+		"SELECT * FROM `teams` WHERE `id` = ? LIMIT 1 FOR UPDATE",
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "チームが見つかりません", nil)
 	}
-	if ok, err := contestStatusRestricted(e, tx, resourcespb.Contest_STARTED, "競技時間外はベンチマークを実行できません"); !ok {
-		return wrapError("check contest status", err)
+	if err != nil {
+		return fmt.Errorf("get team with lock: %w", err)
 	}
-	// TODO: 中でgetCurrentContestantが呼ばれる
+	if ok, err := contestWritable(e, tx, team.ContestID); !ok {
+		return wrapError("check contest", err)
+	}
+
+	emailAddress := team.EmailAddress
+	if req.Team.Detail != nil {
+		emailAddress = req.Team.Detail.EmailAddress
+	}
+	leaderID := sql.NullString{String: req.Team.LeaderId, Valid: req.Team.LeaderId != ""}
+	_, err = tx.Exec(
+		"UPDATE `teams` SET `name` = ?, `email_address` = ?, `leader_id` = ?, `withdrawn` = ? WHERE `id` = ? LIMIT 1",
+		req.Team.Name,
+		emailAddress,
+		leaderID,
+		req.Team.Withdrawn,
+		team.ID,
+	)
+	if mErr, ok := err.(*mysql.MySQLError); ok && mErr.Number == MYSQL_ER_DUP_ENTRY {
+		return halt(e, http.StatusBadRequest, "リーダーは既に他のチームのリーダーです", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("update team: %w", err)
+	}
+
+	affectedTeamIDs := map[int64]struct{}{team.ID: {}}
+	for _, c := range req.Contestants {
+		var before xsuportal.Contestant
+		err := tx.Get(
+			&before,
+			"SELECT * FROM `contestants` WHERE `id` = ? LIMIT 1 FOR UPDATE",
+			c.Id,
+		)
+		if err == sql.ErrNoRows {
+			return halt(e, http.StatusBadRequest, fmt.Sprintf("参加者 %s が見つかりません", c.Id), nil)
+		}
+		if err != nil {
+			return fmt.Errorf("get contestant with lock(id=%v): %w", c.Id, err)
+		}
+		if before.TeamID.Valid {
+			affectedTeamIDs[before.TeamID.Int64] = struct{}{}
+		}
+		teamID := sql.NullInt64{Int64: c.TeamId, Valid: c.TeamId != 0}
+		if teamID.Valid {
+			var exists bool
+			err := tx.Get(&exists, "SELECT 1 FROM `teams` WHERE `id` = ? AND `contest_id` = ? LIMIT 1", teamID.Int64, team.ContestID)
+			if err == sql.ErrNoRows {
+				return halt(e, http.StatusBadRequest, fmt.Sprintf("チーム %d が見つかりません", teamID.Int64), nil)
+			}
+			if err != nil {
+				return fmt.Errorf("get team(id=%v): %w", teamID.Int64, err)
+			}
+			affectedTeamIDs[teamID.Int64] = struct{}{}
+		}
+		_, err = tx.Exec(
+			"UPDATE `contestants` SET `name` = ?, `student` = ?, `team_id` = ? WHERE `id` = ? LIMIT 1",
+			c.Name,
+			c.IsStudent,
+			teamID,
+			c.Id,
+		)
+		if err != nil {
+			return fmt.Errorf("update contestant(id=%v): %w", c.Id, err)
+		}
+	}
+
+	for teamID := range affectedTeamIDs {
+		var memberCount int
+		err := tx.Get(
+			&memberCount,
+			"SELECT COUNT(*) AS `cnt` FROM `contestants` WHERE `team_id` = ?",
+			teamID,
+		)
+		if err != nil {
+			return fmt.Errorf("count team member(team_id=%v): %w", teamID, err)
+		}
+		if memberCount > 3 {
+			return halt(e, http.StatusBadRequest, fmt.Sprintf("チーム %d の人数が上限を超えています", teamID), nil)
+		}
+		var leaderMissing bool
+		err = tx.Get(
+			&leaderMissing,
+			"SELECT COUNT(*) > 0 FROM `teams` LEFT JOIN `contestants` ON `contestants`.`id` = `teams`.`leader_id` WHERE `teams`.`id` = ? AND `teams`.`leader_id` IS NOT NULL AND (`contestants`.`team_id` IS NULL OR `contestants`.`team_id` != `teams`.`id`)",
+			teamID,
+		)
+		if err != nil {
+			return fmt.Errorf("check team leader(team_id=%v): %w", teamID, err)
+		}
+		if leaderMissing {
+			return halt(e, http.StatusBadRequest, fmt.Sprintf("チーム %d のリーダーがメンバーに含まれていません", teamID), nil)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	resetDashboardCache()
+	return writeProto(e, http.StatusOK, &adminpb.UpdateTeamResponse{})
+}
+
+// ListBenchmarkJobs は新しい順に最大 limit 件のジョブを返す。続きは最後のジョブの id を before に渡せば取れる。
+func (*AdminService) ListBenchmarkJobs(e echo.Context) error {
+	contestID, err := getAdminContestID(e)
+	if err != nil {
+		return err
+	}
+	limit := BenchmarkJobsDefaultLimit
+	if limitStr := e.QueryParam("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return halt(e, http.StatusBadRequest, "limit が不正です", nil)
+		}
+		if limit > BenchmarkJobsMaxLimit {
+			limit = BenchmarkJobsMaxLimit
+		}
+	}
+	query := "SELECT * FROM `benchmark_jobs` WHERE `contest_id` = ?"
+	params := []interface{}{contestID}
+	if teamIDStr := e.QueryParam("team_id"); teamIDStr != "" {
+		teamID, err := strconv.Atoi(teamIDStr)
+		if err != nil {
+			return fmt.Errorf("parse team id: %w", err)
+		}
+		query += " AND `team_id` = ?"
+		params = append(params, teamID)
+	}
+	if incompleteOnly, _ := strconv.ParseBool(e.QueryParam("incomplete_only")); incompleteOnly {
+		query += " AND `status` IN (?, ?, ?)"
+		params = append(params, resourcespb.BenchmarkJob_PENDING, resourcespb.BenchmarkJob_SENT, resourcespb.BenchmarkJob_RUNNING)
+	}
+	if beforeStr := e.QueryParam("before"); beforeStr != "" {
+		before, err := strconv.ParseInt(beforeStr, 10, 64)
+		if err != nil {
+			return halt(e, http.StatusBadRequest, "before が不正です", nil)
+		}
+		query += " AND `id` < ?"
+		params = append(params, before)
+	}
+	query += " ORDER BY `id` DESC LIMIT ?"
+	params = append(params, limit)
+	var jobs []xsuportal.BenchmarkJob
+	err = db.Select(&jobs, query, params...)
+	if err != nil {
+		return fmt.Errorf("select benchmark jobs: %w", err)
+	}
+
+	// チームはまとめて 1 回で引き、同じチームの pb は使い回す
+	teamPBs := make(map[int64]*resourcespb.Team)
+	if len(jobs) > 0 {
+		var teamIDs []int64
+		for i := range jobs {
+			if _, ok := teamPBs[jobs[i].TeamID]; !ok {
+				teamPBs[jobs[i].TeamID] = nil
+				teamIDs = append(teamIDs, jobs[i].TeamID)
+			}
+		}
+		teamMap, err := getTeams(teamIDs)
+		if err != nil {
+			return fmt.Errorf("query team: %w", err)
+		}
+		for id, team := range teamMap {
+			teamPBs[id], err = makeTeamPB(db, &team, false, false)
+			if err != nil {
+				return fmt.Errorf("make team: %w", err)
+			}
+		}
+	}
+
+	res := &adminpb.ListBenchmarkJobsResponse{}
+	for _, job := range jobs {
+		j := makeBenchmarkJobPB(&job)
+		j.Team = teamPBs[job.TeamID]
+		res.Jobs = append(res.Jobs, j)
+	}
+	return writeProto(e, http.StatusOK, res)
+}
+
+func (*AdminService) GetBenchmarkJob(e echo.Context) error {
+	id, err := strconv.Atoi(e.Param("id"))
+	if err != nil {
+		return fmt.Errorf("parse id: %w", err)
+	}
+	var job xsuportal.BenchmarkJob
+	err = db.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1",
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "ベンチマークジョブが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get benchmark job: %w", err)
+	}
+	j, err := makeAdminBenchmarkJobPB(db, &job)
+	if err != nil {
+		return fmt.Errorf("make benchmark job: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.GetBenchmarkJobResponse{
+		Job: j,
+	})
+}
+
+// INFO: target_id には再実行したい既存ジョブの ID を指定する。0 の場合はチームの最新ジョブと同じホストに対して実行する。
+func (*AdminService) EnqueueBenchmarkJob(e echo.Context) error {
+	var req adminpb.EnqueueBenchmarkJobRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var team xsuportal.Team
+	err = tx.Get(
+		&team,
+		"SELECT * FROM `teams` WHERE `id` = ? LIMIT 1 FOR UPDATE",
+		req.TeamId,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "チームが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get team with lock: %w", err)
+	}
+	if ok, err := contestWritable(e, tx, team.ContestID); !ok {
+		return wrapError("check contest", err)
+	}
+	var target xsuportal.BenchmarkJob
+	if req.TargetId != 0 {
+		err = tx.Get(
+			&target,
+			"SELECT * FROM `benchmark_jobs` WHERE `team_id` = ? AND `id` = ? LIMIT 1",
+			team.ID,
+			req.TargetId,
+		)
+	} else {
+		err = tx.Get(
+			&target,
+			"SELECT * FROM `benchmark_jobs` WHERE `team_id` = ? ORDER BY `id` DESC LIMIT 1",
+			team.ID,
+		)
+	}
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "再実行するベンチマークジョブが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get target benchmark job: %w", err)
+	}
+	var jobCount int
+	err = tx.Get(
+		&jobCount,
+		"SELECT COUNT(*) AS `cnt` FROM `benchmark_jobs` WHERE `team_id` = ? AND `status` IN (?, ?, ?)",
+		team.ID,
+		resourcespb.BenchmarkJob_PENDING,
+		resourcespb.BenchmarkJob_SENT,
+		resourcespb.BenchmarkJob_RUNNING,
+	)
+	if err != nil {
+		return fmt.Errorf("count benchmark job: %w", err)
+	}
+	if jobCount > 0 {
+		return halt(e, http.StatusForbidden, "実行中のベンチマークジョブがあります。キャンセルしてから再実行してください", nil)
+	}
+	res, err := tx.Exec(
+		"INSERT INTO `benchmark_jobs` (`contest_id`, `team_id`, `target_hostname`, `status`, `updated_at`, `created_at`) VALUES (?, ?, ?, ?, NOW(6), ?)",
+		team.ContestID,
+		team.ID,
+		target.TargetHostName,
+		int(resourcespb.BenchmarkJob_PENDING),
+		clock.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("enqueue benchmark job: %w", err)
+	}
+	jobID, _ := res.LastInsertId()
+	var job xsuportal.BenchmarkJob
+	err = tx.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1",
+		jobID,
+	)
+	if err != nil {
+		return fmt.Errorf("get benchmark job: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	j, err := makeAdminBenchmarkJobPB(db, &job)
+	if err != nil {
+		return fmt.Errorf("make benchmark job: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.EnqueueBenchmarkJobResponse{
+		Job: j,
+	})
+}
+
+// INFO: キャンセルしたジョブは finished_at を持たないのでリーダーボードには現れない。ベンチマークサーバーはキャンセル済みのジョブの報告を拒否する。
+func (*AdminService) CancelBenchmarkJob(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+
+	id, err := strconv.Atoi(e.Param("id"))
+	if err != nil {
+		return fmt.Errorf("parse id: %w", err)
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	var job xsuportal.BenchmarkJob
+	err = tx.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1 FOR UPDATE",
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "ベンチマークジョブが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get benchmark job with lock: %w", err)
+	}
+	if ok, err := contestWritable(e, tx, job.ContestID); !ok {
+		return wrapError("check contest", err)
+	}
+	switch resourcespb.BenchmarkJob_Status(job.Status) {
+	case resourcespb.BenchmarkJob_PENDING, resourcespb.BenchmarkJob_SENT, resourcespb.BenchmarkJob_RUNNING:
+	default:
+		return halt(e, http.StatusBadRequest, "完了したベンチマークジョブはキャンセルできません", nil)
+	}
+	_, err = tx.Exec(
+		"UPDATE `benchmark_jobs` SET `status` = ?, `reason` = ?, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+		resourcespb.BenchmarkJob_CANCELLED,
+		fmt.Sprintf("Cancelled by %s", contestant.ID),
+		job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("cancel benchmark job: %w", err)
+	}
+	err = tx.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1",
+		job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("get benchmark job: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	j, err := makeAdminBenchmarkJobPB(db, &job)
+	if err != nil {
+		return fmt.Errorf("make benchmark job: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.CancelBenchmarkJobResponse{
+		Job: j,
+	})
+}
+
+// ListBenchmarkQueueWaits はジョブが積まれてから渡されるまでの待ち時間をチームごとに集計して返す。
+// 永続化した dispatched_at から求めるので、ベンチマークサーバーを再起動したり複数台にしたりしても値は変わらない。
+func (*AdminService) ListBenchmarkQueueWaits(e echo.Context) error {
+	contestID, err := getAdminContestID(e)
+	if err != nil {
+		return err
+	}
+	var waits []struct {
+		TeamID      int64   `db:"team_id"`
+		Count       int64   `db:"count"`
+		AverageWait float64 `db:"average_wait"`
+		MaxWait     int64   `db:"max_wait"`
+	}
+	err = db.Select(
+		&waits,
+		"SELECT `team_id`, COUNT(*) AS `count`, AVG(TIMESTAMPDIFF(MICROSECOND, `created_at`, `dispatched_at`)) AS `average_wait`, MAX(TIMESTAMPDIFF(MICROSECOND, `created_at`, `dispatched_at`)) AS `max_wait` FROM `benchmark_jobs` WHERE `contest_id` = ? AND `dispatched_at` IS NOT NULL GROUP BY `team_id` ORDER BY `team_id`",
+		contestID,
+	)
+	if err != nil {
+		return fmt.Errorf("select benchmark queue waits: %w", err)
+	}
+	res := &adminpb.ListBenchmarkQueueWaitsResponse{}
+	for _, w := range waits {
+		res.Teams = append(res.Teams, &adminpb.ListBenchmarkQueueWaitsResponse_TeamQueueWait{
+			TeamId:                  w.TeamID,
+			Count:                   w.Count,
+			AverageWaitMicroseconds: int64(w.AverageWait),
+			MaxWaitMicroseconds:     w.MaxWait,
+		})
+	}
+	return writeProto(e, http.StatusOK, res)
+}
+
+// ListContestantSessions はコンテスタントの有効なセッションを返す。
+func (*AdminService) ListContestantSessions(e echo.Context) error {
+	rows, err := sessionStore.ListByContestant(e.Param("id"))
+	if err != nil {
+		return err
+	}
+	sessions := make([]*adminpb.ListContestantSessionsResponse_Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, &adminpb.ListContestantSessionsResponse_Session{
+			Id:        row.ID,
+			CreatedAt: timestamppb.New(row.CreatedAt),
+			UpdatedAt: timestamppb.New(row.UpdatedAt),
+			ExpiresAt: timestamppb.New(row.ExpiresAt),
+		})
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ListContestantSessionsResponse{
+		Sessions: sessions,
+	})
+}
+
+// RevokeContestantSessions は session_id で指定したセッション、指定がなければコンテスタントの全セッションを失効させる。
+func (*AdminService) RevokeContestantSessions(e echo.Context) error {
+	n, err := sessionStore.RevokeByContestant(e.Param("id"), e.Param("session_id"))
+	if err != nil {
+		return err
+	}
+	if n == 0 && e.Param("session_id") != "" {
+		return halt(e, http.StatusNotFound, "セッションが見つかりません", nil)
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// ListLoginAttempts はロック中か、最近ログインに失敗したコンテスタント ID と IP を返す。
+func (*AdminService) ListLoginAttempts(e echo.Context) error {
+	var attempts []xsuportal.LoginAttempt
+	err := db.Select(
+		&attempts,
+		"SELECT * FROM `login_attempts` WHERE `locked_until` > NOW(6) OR `last_failed_at` > TIMESTAMPADD(MICROSECOND, ?, NOW(6)) ORDER BY `last_failed_at` DESC",
+		-loginThrottle.Window.Microseconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("select login attempts: %w", err)
+	}
+	pbs := make([]*adminpb.ListLoginAttemptsResponse_LoginAttempt, 0, len(attempts))
+	for _, attempt := range attempts {
+		pb := &adminpb.ListLoginAttemptsResponse_LoginAttempt{
+			Scope:        attempt.Scope,
+			Key:          attempt.Key,
+			Failures:     int64(attempt.Failures),
+			LastFailedAt: timestamppb.New(attempt.LastFailedAt),
+		}
+		if attempt.LockedUntil.Valid {
+			pb.LockedUntil = timestamppb.New(attempt.LockedUntil.Time)
+		}
+		pbs = append(pbs, pb)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ListLoginAttemptsResponse{
+		LoginAttempts: pbs,
+	})
+}
+
+// ClearLoginAttempts は scope (contestant か ip) と key で指定したロックを解除する。
+func (*AdminService) ClearLoginAttempts(e echo.Context) error {
+	key, err := url.PathUnescape(e.Param("key"))
+	if err != nil {
+		return halt(e, http.StatusBadRequest, "key が不正です", nil)
+	}
+	cleared, err := loginThrottle.Clear(db, xsuportal.LoginThrottleKey{Scope: e.Param("scope"), Key: key})
+	if err != nil {
+		return err
+	}
+	if !cleared {
+		return halt(e, http.StatusNotFound, "ロックが見つかりません", nil)
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+// ListStaffs はスタッフとロールを返す。
+func (*AdminService) ListStaffs(e echo.Context) error {
+	var staffs []xsuportal.Staff
+	err := db.Select(
+		&staffs,
+		"SELECT * FROM `staffs` ORDER BY `id`",
+	)
+	if err != nil {
+		return fmt.Errorf("select staffs: %w", err)
+	}
+	pbs := make([]*resourcespb.Staff, 0, len(staffs))
+	for i := range staffs {
+		pbs = append(pbs, makeStaffPB(&staffs[i]))
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ListStaffsResponse{
+		Staffs: pbs,
+	})
+}
+
+// UpdateStaff はコンテスタントをスタッフにするか、スタッフのロールを変える。
+func (*AdminService) UpdateStaff(e echo.Context) error {
+	var req adminpb.UpdateStaffRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	if !xsuportal.IsStaffRole(req.Role) {
+		return halt(e, http.StatusBadRequest, fmt.Sprintf("role %q は存在しません", req.Role), nil)
+	}
+	contestantID := e.Param("contestant_id")
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(
+		"UPDATE `contestants` SET `staff` = TRUE WHERE `id` = ? LIMIT 1",
+		contestantID,
+	)
+	if err != nil {
+		return fmt.Errorf("update contestant: %w", err)
+	}
+	var exists bool
+	if n, _ := res.RowsAffected(); n == 0 {
+		err := tx.Get(&exists, "SELECT 1 FROM `contestants` WHERE `id` = ? LIMIT 1", contestantID)
+		if err == sql.ErrNoRows {
+			return halt(e, http.StatusNotFound, "コンテスタントが見つかりません", nil)
+		}
+		if err != nil {
+			return fmt.Errorf("get contestant: %w", err)
+		}
+	}
+	_, err = tx.Exec(
+		"INSERT INTO `staffs` (`contestant_id`, `github_login`, `role`, `created_at`, `updated_at`) VALUES (?, ?, ?, NOW(6), NOW(6)) ON DUPLICATE KEY UPDATE `github_login` = VALUES(`github_login`), `role` = VALUES(`role`), `updated_at` = NOW(6)",
+		contestantID,
+		sql.NullString{String: req.GithubLogin, Valid: req.GithubLogin != ""},
+		req.Role,
+	)
+	if err != nil {
+		return fmt.Errorf("upsert staff: %w", err)
+	}
+	var staff xsuportal.Staff
+	err = tx.Get(
+		&staff,
+		"SELECT * FROM `staffs` WHERE `contestant_id` = ? LIMIT 1",
+		contestantID,
+	)
+	if err != nil {
+		return fmt.Errorf("get staff: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &adminpb.UpdateStaffResponse{
+		Staff: makeStaffPB(&staff),
+	})
+}
+
+// DeleteStaff はスタッフ権限を外す。自分自身の権限は外せない。
+func (*AdminService) DeleteStaff(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+	contestantID := e.Param("contestant_id")
+	if contestantID == contestant.ID {
+		return halt(e, http.StatusBadRequest, "自分自身のスタッフ権限は外せません", nil)
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(
+		"UPDATE `contestants` SET `staff` = FALSE WHERE `id` = ? AND `staff` = TRUE LIMIT 1",
+		contestantID,
+	)
+	if err != nil {
+		return fmt.Errorf("update contestant: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return halt(e, http.StatusNotFound, "スタッフが見つかりません", nil)
+	}
+	_, err = tx.Exec(
+		"DELETE FROM `staffs` WHERE `contestant_id` = ? LIMIT 1",
+		contestantID,
+	)
+	if err != nil {
+		return fmt.Errorf("delete staff: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+type CommonService struct{}
+
+func (*CommonService) GetCurrentSession(e echo.Context) error {
+	res := &commonpb.GetCurrentSessionResponse{}
+	currentContestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return fmt.Errorf("get current contestant: %w", err)
+	}
+	if currentContestant != nil {
+		res.Contestant = makeContestantPB(currentContestant)
+	}
+	// TODO: 中でgetCurrentContestantが呼ばれる
+	currentTeam, err := getCurrentTeam(e, db, false)
+	if err != nil {
+		return fmt.Errorf("get current team: %w", err)
+	}
+	if currentTeam == nil && res.Contestant != nil {
+		// 以前のコンテストのチームはアクティブなコンテストでの所属ではない
+		res.Contestant.TeamId = 0
+	}
+	if currentTeam != nil {
+		res.Team, err = makeTeamPB(db, currentTeam, true, true)
+		if err != nil {
+			return fmt.Errorf("make team: %w", err)
+		}
+	}
+	res.Contest, err = makeContestPB(e)
+	if err != nil {
+		return fmt.Errorf("make contest: %w", err)
+	}
+	vapidKey := notifier.VAPIDKey()
+	if vapidKey != nil {
+		res.PushVapidKey = vapidKey.VAPIDPublicKey
+	}
+	return writeProto(e, http.StatusOK, res)
+}
+
+// GetClock はコンテストの進行に使っている時刻と、リハーサル中ならそのずらし方を返す。
+func (*AdminService) GetClock(e echo.Context) error {
+	return writeProto(e, http.StatusOK, &adminpb.GetClockResponse{
+		Clock: makeClockPB(),
+	})
+}
+
+// UpdateClock はリハーサル中の時計をずらすか止める。fixed_at を指定すると offset_seconds より優先してその時刻で止まる。
+func (*AdminService) UpdateClock(e echo.Context) error {
+	if rehearsalClock == nil {
+		return halt(e, http.StatusBadRequest, "リハーサルモードではないため時計は変更できません", nil)
+	}
+	var req adminpb.UpdateClockRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	offset := time.Duration(req.OffsetSeconds * float64(time.Second))
+	var fixedAt sql.NullTime
+	if req.FixedAt != nil {
+		fixedAt = sql.NullTime{Time: req.FixedAt.AsTime().Round(time.Microsecond), Valid: true}
+	}
+	if err := rehearsalClock.Save(db, offset, fixedAt); err != nil {
+		return fmt.Errorf("save clock: %w", err)
+	}
+	resetDashboardCache()
+	return writeProto(e, http.StatusOK, &adminpb.UpdateClockResponse{
+		Clock: makeClockPB(),
+	})
+}
+
+// ListContests はアーカイブ済みも含めた全てのコンテストを返す。
+func (*AdminService) ListContests(e echo.Context) error {
+	var contests []xsuportal.Contest
+	err := db.Select(&contests, "SELECT * FROM `contests` ORDER BY `id`")
+	if err != nil {
+		return fmt.Errorf("select contests: %w", err)
+	}
+	now := clock.Now()
+	pbs := make([]*resourcespb.Contest, 0, len(contests))
+	for i := range contests {
+		pbs = append(pbs, makeContestStatusPB(contests[i].StatusAt(now)))
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ListContestsResponse{
+		Contests: pbs,
+	})
+}
+
+// CreateContest は新しいコンテストを作る。作ったコンテストは ActivateContest するまでアクティブにならない。
+func (*AdminService) CreateContest(e echo.Context) error {
+	var req adminpb.CreateContestRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	c := req.Contest
+	if c == nil || c.Name == "" {
+		return halt(e, http.StatusBadRequest, "name を指定してください", nil)
+	}
+	if c.RegistrationOpenAt == nil || c.ContestStartsAt == nil || c.ContestFreezesAt == nil || c.ContestEndsAt == nil {
+		return halt(e, http.StatusBadRequest, "全ての日時を指定してください", nil)
+	}
+	registrationOpenAt := c.RegistrationOpenAt.AsTime().Round(time.Microsecond)
+	contestStartsAt := c.ContestStartsAt.AsTime().Round(time.Microsecond)
+	contestFreezesAt := c.ContestFreezesAt.AsTime().Round(time.Microsecond)
+	contestEndsAt := c.ContestEndsAt.AsTime().Round(time.Microsecond)
+	if message := checkContestSchedule(registrationOpenAt, contestStartsAt, contestFreezesAt, contestEndsAt); message != "" {
+		return halt(e, http.StatusBadRequest, message, nil)
+	}
+	res, err := db.Exec(
+		"INSERT INTO `contests` (`name`, `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`, `active`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?, FALSE, NOW(6), NOW(6))",
+		c.Name,
+		registrationOpenAt,
+		contestStartsAt,
+		contestFreezesAt,
+		contestEndsAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert contest: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("get inserted contest id: %w", err)
+	}
+	contest, err := xsuportal.GetContest(db, id)
+	if err != nil {
+		return err
+	}
+	return writeProto(e, http.StatusCreated, &adminpb.CreateContestResponse{
+		Contest: makeContestStatusPB(contest.StatusAt(clock.Now())),
+	})
+}
+
+// ActivateContest はコンテストをアクティブにし、それまでアクティブだったコンテストを非アクティブにする。
+// 非アクティブになったコンテストのデータはそのまま残り、contest_id を指定すれば閲覧できる。
+func (*AdminService) ActivateContest(e echo.Context) error {
+	id, err := strconv.ParseInt(e.Param("id"), 10, 64)
+	if err != nil {
+		return halt(e, http.StatusBadRequest, "id が不正です", nil)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	var contest xsuportal.Contest
+	err = tx.Get(&contest, "SELECT * FROM `contests` WHERE `id` = ? LIMIT 1 FOR UPDATE", id)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "コンテストが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get contest: %w", err)
+	}
+	if contest.Archived() {
+		return halt(e, http.StatusConflict, "アーカイブ済みのコンテストはアクティブにできません", nil)
+	}
+	_, err = tx.Exec("UPDATE `contests` SET `active` = FALSE, `updated_at` = NOW(6) WHERE `active` = TRUE AND `id` != ?", id)
+	if err != nil {
+		return fmt.Errorf("deactivate contests: %w", err)
+	}
+	_, err = tx.Exec("UPDATE `contests` SET `active` = TRUE, `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1", id)
+	if err != nil {
+		return fmt.Errorf("activate contest: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	resetDashboardCache()
+	getXsuportalContext(e).ContestStatus = nil
+
+	activated, err := xsuportal.GetContest(db, id)
+	if err != nil {
+		return err
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ActivateContestResponse{
+		Contest: makeContestStatusPB(activated.StatusAt(clock.Now())),
+	})
+}
+
+// ArchiveContest はコンテストを読み取り専用にする。アクティブなコンテストは先に別のコンテストをアクティブにしてからでないとアーカイブできない。
+func (*AdminService) ArchiveContest(e echo.Context) error {
+	id, err := strconv.ParseInt(e.Param("id"), 10, 64)
+	if err != nil {
+		return halt(e, http.StatusBadRequest, "id が不正です", nil)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	var contest xsuportal.Contest
+	err = tx.Get(&contest, "SELECT * FROM `contests` WHERE `id` = ? LIMIT 1 FOR UPDATE", id)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "コンテストが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get contest: %w", err)
+	}
+	if contest.Active {
+		return halt(e, http.StatusConflict, "アクティブなコンテストはアーカイブできません", nil)
+	}
+	if !contest.Archived() {
+		_, err = tx.Exec("UPDATE `contests` SET `archived_at` = NOW(6), `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1", id)
+		if err != nil {
+			return fmt.Errorf("archive contest: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	archived, err := xsuportal.GetContest(db, id)
+	if err != nil {
+		return err
+	}
+	return writeProto(e, http.StatusOK, &adminpb.ArchiveContestResponse{
+		Contest: makeContestStatusPB(archived.StatusAt(clock.Now())),
+	})
+}
+
+type ContestantService struct{}
+
+/*
+	INFO:
+	仮想選手は、エンキューができる状況にあるときは即時にエンキューを試みます。以下のような状況にある場合は仮想選手はエンキューをせず、状況が変化するのを待ちます。
+	- まだ FINISHED になっていない所属仮想チームのジョブ（PENDING, SENT, RUNNING）がすでにあり、まだ仮想負荷走行終了の通知を受け取っていないとき
+	- 仮想選手本人あるいは所属仮想チームのメンバーが仮想運営への質問（Clarification）を投稿したあと、まだ仮想運営からの回答を確認していないとき
+*/
+func (*ContestantService) EnqueueBenchmarkJob(e echo.Context) error {
+	var req contestantpb.EnqueueBenchmarkJobRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	// TODO: 中でgetCurrentContestantが呼ばれる
+	if ok, err := loginRequired(e, tx, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+	if ok, err := contestStatusRestricted(e, tx, resourcespb.Contest_STARTED, "競技時間外はベンチマークを実行できません"); !ok {
+		return wrapError("check contest status", err)
+	}
+	// TODO: 中でgetCurrentContestantが呼ばれる
 	team, _ := getCurrentTeam(e, tx, false)
 	var jobCount int
 	err = tx.Get(
 		&jobCount,
-		"SELECT COUNT(*) AS `cnt` FROM `benchmark_jobs` WHERE `team_id` = ? AND `finished_at` IS NULL",
+		"SELECT COUNT(*) AS `cnt` FROM `benchmark_jobs` WHERE `team_id` = ? AND `status` IN (?, ?, ?)",
 		team.ID,
+		resourcespb.BenchmarkJob_PENDING,
+		resourcespb.BenchmarkJob_SENT,
+		resourcespb.BenchmarkJob_RUNNING,
 	)
 	if err != nil {
 		return fmt.Errorf("count benchmark job: %w", err)
@@ -468,10 +1724,12 @@ func (*ContestantService) EnqueueBenchmarkJob(e echo.Context) error {
 		return halt(e, http.StatusForbidden, "既にベンチマークを実行中です", nil)
 	}
 	_, err = tx.Exec(
-		"INSERT INTO `benchmark_jobs` (`team_id`, `target_hostname`, `status`, `updated_at`, `created_at`) VALUES (?, ?, ?, NOW(6), NOW(6))",
+		"INSERT INTO `benchmark_jobs` (`contest_id`, `team_id`, `target_hostname`, `status`, `updated_at`, `created_at`) VALUES (?, ?, ?, ?, NOW(6), ?)",
+		team.ContestID,
 		team.ID,
 		req.TargetHostname,
 		int(resourcespb.BenchmarkJob_PENDING),
+		clock.Now(),
 	)
 	if err != nil {
 		return fmt.Errorf("enqueue benchmark job: %w", err)
@@ -530,11 +1788,110 @@ func (*ContestantService) GetBenchmarkJob(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("get benchmark job: %w", err)
 	}
+	var progresses []xsuportal.BenchmarkJobProgress
+	err = db.Select(
+		&progresses,
+		"SELECT * FROM `benchmark_job_progresses` WHERE `benchmark_job_id` = ? ORDER BY `id`",
+		job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("select benchmark job progresses: %w", err)
+	}
+	pbs := make([]*resourcespb.BenchmarkResult, 0, len(progresses))
+	for i := range progresses {
+		pbs = append(pbs, makeBenchmarkProgressPB(&progresses[i]))
+	}
+	j := makeBenchmarkJobPB(&job)
+	if !job.FinishedAt.Valid && len(pbs) > 0 {
+		// 実行中は最新の途中経過を Result としても返す
+		j.Result = pbs[len(pbs)-1]
+	}
 	return writeProto(e, http.StatusOK, &contestantpb.GetBenchmarkJobResponse{
-		Job: makeBenchmarkJobPB(&job),
+		Job:        j,
+		Progresses: pbs,
 	})
 }
 
+// INFO: Server-Sent Events でジョブのタイムラインを流す。まず Last-Event-ID 以降の途中経過を全て送り、
+// その後は新しい途中経過が届くたびに "progress" イベントを、ジョブが終わったら "finished" イベントを送って閉じる。
+// data はそれぞれ resources.BenchmarkResult と resources.BenchmarkJob を protobuf にして base64 にしたもの。
+func (*ContestantService) StreamBenchmarkJobProgress(e echo.Context) error {
+	if ok, err := loginRequired(e, db, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+	id, err := strconv.Atoi(e.Param("id"))
+	if err != nil {
+		return fmt.Errorf("parse id: %w", err)
+	}
+	team, _ := getCurrentTeam(e, db, false)
+	var job xsuportal.BenchmarkJob
+	err = db.Get(
+		&job,
+		"SELECT * FROM `benchmark_jobs` WHERE `team_id` = ? AND `id` = ? LIMIT 1",
+		team.ID,
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return halt(e, http.StatusNotFound, "ベンチマークジョブが見つかりません", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("get benchmark job: %w", err)
+	}
+	var lastID int64
+	if lastEventID := e.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		lastID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			return halt(e, http.StatusBadRequest, "Last-Event-ID が不正です", nil)
+		}
+	}
+
+	startEventStream(e)
+	ctx := e.Request().Context()
+	// 途中経過は別プロセス (benchmark_server) が書き込むので、Updated だけでなく定期的にも確認する
+	poll := time.NewTicker(BenchmarkJobProgressStreamPollInterval)
+	defer poll.Stop()
+	for {
+		updated := notifier.Updated()
+		var progresses []xsuportal.BenchmarkJobProgress
+		err := db.Select(
+			&progresses,
+			"SELECT * FROM `benchmark_job_progresses` WHERE `benchmark_job_id` = ? AND `id` > ? ORDER BY `id`",
+			job.ID,
+			lastID,
+		)
+		if err != nil {
+			return fmt.Errorf("select benchmark job progresses: %w", err)
+		}
+		for _, progress := range progresses {
+			if err := writeEvent(e, strconv.FormatInt(progress.ID, 10), "progress", makeBenchmarkProgressPB(&progress)); err != nil {
+				return nil
+			}
+			lastID = progress.ID
+		}
+		err = db.Get(
+			&job,
+			"SELECT * FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1",
+			job.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("get benchmark job: %w", err)
+		}
+		switch {
+		case job.FinishedAt.Valid,
+			resourcespb.BenchmarkJob_Status(job.Status) == resourcespb.BenchmarkJob_CANCELLED,
+			resourcespb.BenchmarkJob_Status(job.Status) == resourcespb.BenchmarkJob_ERRORED:
+			writeEvent(e, "", "finished", makeBenchmarkJobPB(&job))
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-updated:
+		case <-poll.C:
+		}
+	}
+}
+
 func (*ContestantService) ListClarifications(e echo.Context) error {
 	// TODO: 中でgetCurrentContestantが呼ばれる
 	if ok, err := loginRequired(e, db, &loginRequiredOption{Team: true}); !ok {
@@ -545,7 +1902,8 @@ func (*ContestantService) ListClarifications(e echo.Context) error {
 	var clarifications []xsuportal.Clarification
 	err := db.Select(
 		&clarifications,
-		"SELECT * FROM `clarifications` WHERE `team_id` = ? OR `disclosed` = TRUE ORDER BY `id` DESC",
+		"SELECT * FROM `clarifications` WHERE `contest_id` = ? AND (`team_id` = ? OR `disclosed` = TRUE) ORDER BY `id` DESC",
+		team.ContestID,
 		team.ID,
 	)
 	if err != sql.ErrNoRows && err != nil {
@@ -592,10 +1950,14 @@ func (*ContestantService) RequestClarification(e echo.Context) error {
 	defer tx.Rollback()
 	// TODO: 中でgetCurrentContestantが呼ばれる
 	team, _ := getCurrentTeam(e, tx, false)
+	now := clock.Now()
 	_, err = tx.Exec(
-		"INSERT INTO `clarifications` (`team_id`, `question`, `created_at`, `updated_at`) VALUES (?, ?, NOW(6), NOW(6))",
+		"INSERT INTO `clarifications` (`contest_id`, `team_id`, `question`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?)",
+		team.ContestID,
 		team.ID,
 		req.Question,
+		now,
+		now,
 	)
 	if err != nil {
 		return fmt.Errorf("insert clarification: %w", err)
@@ -626,13 +1988,16 @@ func (*ContestantService) Dashboard(e echo.Context) error {
 	}
 	// TODO: 中でgetCurrentContestantが呼ばれる
 	team, _ := getCurrentTeam(e, db, false)
-	res, err := makeLeaderboardPB(e, team.ID)
+	res, err := makeLeaderboardPB(e, team.ID, false)
 	if err != nil {
 		return fmt.Errorf("make leaderboard: %w", err)
 	}
 	return e.Blob(http.StatusOK, "application/vnd.google.protobuf", res)
 }
 
+// ListNotifications は通知を返すだけで既読にはしない。既読にするには MarkNotificationsRead を呼ぶ。
+// after を指定すると after より後の通知を古い順に、指定しなければ before より前 (なければ最新) の通知を limit 件まで返す。
+// どちらも id の昇順で並ぶので、前者は最後の id を after に、後者は最初の id を before に渡せば続きが取れる。
 func (*ContestantService) ListNotifications(e echo.Context) error {
 	contestant, err := getCurrentContestant(e, db, false)
 	if err != nil {
@@ -641,56 +2006,67 @@ func (*ContestantService) ListNotifications(e echo.Context) error {
 	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{Team: true}); !ok {
 		return wrapError("check session", err)
 	}
+	team, _ := getCurrentTeam(e, db, false)
 
-	afterStr := e.QueryParam("after")
-
-	tx, err := db.Beginx()
-	if err != nil {
-		return fmt.Errorf("begin tx: %w", err)
+	limit := NotificationsDefaultLimit
+	if limitStr := e.QueryParam("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return halt(e, http.StatusBadRequest, "limit が不正です", nil)
+		}
+		if limit > NotificationsMaxLimit {
+			limit = NotificationsMaxLimit
+		}
 	}
-	defer tx.Rollback()
 
 	var notifications []*xsuportal.Notification
-	if afterStr != "" {
+	if afterStr := e.QueryParam("after"); afterStr != "" {
 		after, err := strconv.Atoi(afterStr)
 		if err != nil {
 			return fmt.Errorf("parse after: %w", err)
 		}
-		err = tx.Select(
+		err = db.Select(
 			&notifications,
-			"SELECT * FROM `notifications` WHERE `contestant_id` = ? AND `id` > ? ORDER BY `id`",
+			"SELECT * FROM `notifications` WHERE `contestant_id` = ? AND `contest_id` = ? AND `id` > ? ORDER BY `id` LIMIT ?",
 			contestant.ID,
+			team.ContestID,
 			after,
+			limit,
 		)
 		if err != sql.ErrNoRows && err != nil {
 			return fmt.Errorf("select notifications(after=%v): %w", after, err)
 		}
 	} else {
-		err = tx.Select(
+		// after がなければ before より前 (なければ最新) の limit 件を返す
+		var before int64 = math.MaxInt64
+		if beforeStr := e.QueryParam("before"); beforeStr != "" {
+			before, err = strconv.ParseInt(beforeStr, 10, 64)
+			if err != nil {
+				return halt(e, http.StatusBadRequest, "before が不正です", nil)
+			}
+		}
+		err = db.Select(
 			&notifications,
-			"SELECT * FROM `notifications` WHERE `contestant_id` = ? ORDER BY `id`",
+			"SELECT * FROM (SELECT * FROM `notifications` WHERE `contestant_id` = ? AND `contest_id` = ? AND `id` < ? ORDER BY `id` DESC LIMIT ?) AS `n` ORDER BY `id`",
 			contestant.ID,
+			team.ContestID,
+			before,
+			limit,
 		)
 		if err != sql.ErrNoRows && err != nil {
-			return fmt.Errorf("select notifications: %w", err)
+			return fmt.Errorf("select notifications(before=%v): %w", before, err)
 		}
 	}
-	_, err = tx.Exec(
-		"UPDATE `notifications` SET `read` = TRUE WHERE `contestant_id` = ? AND `read` = FALSE",
-		contestant.ID,
-	)
+	unreadCount, err := countUnreadNotifications(db, contestant.ID, team.ContestID)
 	if err != nil {
-		return fmt.Errorf("update notifications: %w", err)
-	}
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit tx: %w", err)
+		return err
 	}
-	team, _ := getCurrentTeam(e, db, false)
 
 	var lastAnsweredClarificationID int64
 	err = db.Get(
 		&lastAnsweredClarificationID,
-		"SELECT `id` FROM `clarifications` WHERE (`team_id` = ? OR `disclosed` = TRUE) AND `answered_at` IS NOT NULL ORDER BY `id` DESC LIMIT 1",
+		"SELECT `id` FROM `clarifications` WHERE `contest_id` = ? AND (`team_id` = ? OR `disclosed` = TRUE) AND `answered_at` IS NOT NULL ORDER BY `id` DESC LIMIT 1",
+		team.ContestID,
 		team.ID,
 	)
 	if err != sql.ErrNoRows && err != nil {
@@ -700,10 +2076,130 @@ func (*ContestantService) ListNotifications(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("make notifications: %w", err)
 	}
-	return writeProto(e, http.StatusOK, &contestantpb.ListNotificationsResponse{
-		Notifications:               ns,
-		LastAnsweredClarificationId: lastAnsweredClarificationID,
-	})
+	return writeProto(e, http.StatusOK, &contestantpb.ListNotificationsResponse{
+		Notifications:               ns,
+		LastAnsweredClarificationId: lastAnsweredClarificationID,
+		UnreadCount:                 unreadCount,
+	})
+}
+
+// MarkNotificationsRead は ids で指定した通知、または up_to 以下の id の通知を既読にし、既読にした後の未読数を返す。
+func (*ContestantService) MarkNotificationsRead(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+	team, _ := getCurrentTeam(e, db, false)
+	var req contestantpb.MarkNotificationsReadRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	switch {
+	case len(req.Ids) > 0 && req.UpTo != 0:
+		return halt(e, http.StatusBadRequest, "ids と up_to は同時に指定できません", nil)
+	case len(req.Ids) > 0:
+		query, params, err := sqlx.In(
+			"UPDATE `notifications` SET `read` = TRUE, `updated_at` = NOW(6) WHERE `contestant_id` = ? AND `contest_id` = ? AND `id` IN (?) AND `read` = FALSE",
+			contestant.ID,
+			team.ContestID,
+			req.Ids,
+		)
+		if err != nil {
+			return fmt.Errorf("build query: %w", err)
+		}
+		if _, err := db.Exec(query, params...); err != nil {
+			return fmt.Errorf("update notifications: %w", err)
+		}
+	case req.UpTo != 0:
+		_, err = db.Exec(
+			"UPDATE `notifications` SET `read` = TRUE, `updated_at` = NOW(6) WHERE `contestant_id` = ? AND `contest_id` = ? AND `id` <= ? AND `read` = FALSE",
+			contestant.ID,
+			team.ContestID,
+			req.UpTo,
+		)
+		if err != nil {
+			return fmt.Errorf("update notifications: %w", err)
+		}
+	default:
+		return halt(e, http.StatusBadRequest, "ids か up_to を指定してください", nil)
+	}
+	unreadCount, err := countUnreadNotifications(db, contestant.ID, team.ContestID)
+	if err != nil {
+		return err
+	}
+	return writeProto(e, http.StatusOK, &contestantpb.MarkNotificationsReadResponse{
+		UnreadCount: unreadCount,
+	})
+}
+
+// StreamNotifications は通知を作られた順に Server-Sent Events で流し続ける。
+// 再接続時は Last-Event-ID (なければ after) より後の通知から再開する。既読にはしない。
+func (*ContestantService) StreamNotifications(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+	team, _ := getCurrentTeam(e, db, false)
+	lastEventID := e.Request().Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = e.QueryParam("after")
+	}
+	var lastID int64
+	if lastEventID != "" {
+		lastID, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			return halt(e, http.StatusBadRequest, "Last-Event-ID が不正です", nil)
+		}
+	}
+
+	startEventStream(e)
+	ctx := e.Request().Context()
+	// 通知は別プロセス (benchmark_server) の outbox でも作られるので、Updated だけでなく定期的にも確認する
+	poll := time.NewTicker(NotificationStreamPollInterval)
+	defer poll.Stop()
+	keepAlive := time.NewTicker(NotificationStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		updated := notifier.Updated()
+		var notifications []*xsuportal.Notification
+		err := db.Select(
+			&notifications,
+			"SELECT * FROM `notifications` WHERE `contestant_id` = ? AND `contest_id` = ? AND `id` > ? ORDER BY `id`",
+			contestant.ID,
+			team.ContestID,
+			lastID,
+		)
+		if err != nil {
+			return fmt.Errorf("select notifications: %w", err)
+		}
+		ns, err := makeNotificationsPB(notifications)
+		if err != nil {
+			return fmt.Errorf("make notifications: %w", err)
+		}
+		for _, n := range ns {
+			if err := writeEvent(e, strconv.FormatInt(n.Id, 10), "notification", n); err != nil {
+				return nil
+			}
+			lastID = n.Id
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-updated:
+		case <-poll.C:
+		case <-keepAlive.C:
+			if _, err := e.Response().Write([]byte(": keep-alive\n\n")); err != nil {
+				return nil
+			}
+			e.Response().Flush()
+		}
+	}
 }
 
 func (*ContestantService) SubscribeNotification(e echo.Context) error {
@@ -763,17 +2259,103 @@ func (*ContestantService) UnsubscribeNotification(e echo.Context) error {
 	return writeProto(e, http.StatusOK, &contestantpb.UnsubscribeNotificationResponse{})
 }
 
+func makeNotificationPreferencePBs(db sqlx.Queryer, contestantID string) ([]*contestantpb.NotificationPreference, error) {
+	prefs, err := xsuportal.GetNotificationPreferences(db, []string{contestantID})
+	if err != nil {
+		return nil, err
+	}
+	pbs := make([]*contestantpb.NotificationPreference, 0, len(xsuportal.NotificationContentTypes))
+	for _, contentType := range xsuportal.NotificationContentTypes {
+		pref := prefs.Get(contestantID, contentType)
+		pbs = append(pbs, &contestantpb.NotificationPreference{
+			ContentType: pref.ContentType,
+			InApp:       pref.InApp,
+			WebPush:     pref.WebPush,
+		})
+	}
+	return pbs, nil
+}
+
+func (*ContestantService) GetNotificationPreferences(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+	pbs, err := makeNotificationPreferencePBs(db, contestant.ID)
+	if err != nil {
+		return err
+	}
+	return writeProto(e, http.StatusOK, &contestantpb.GetNotificationPreferencesResponse{
+		Preferences: pbs,
+	})
+}
+
+// UpdateNotificationPreferences は指定した content type の設定だけを上書きする。
+func (*ContestantService) UpdateNotificationPreferences(e echo.Context) error {
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return wrapError("check session", err)
+	}
+	if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{Team: true}); !ok {
+		return wrapError("check session", err)
+	}
+
+	var req contestantpb.UpdateNotificationPreferencesRequest
+	if err := e.Bind(&req); err != nil {
+		return err
+	}
+	for _, pref := range req.Preferences {
+		if !xsuportal.IsNotificationContentType(pref.ContentType) {
+			return halt(e, http.StatusBadRequest, fmt.Sprintf("content_type %q は存在しません", pref.ContentType), nil)
+		}
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	for _, pref := range req.Preferences {
+		_, err := tx.Exec(
+			"INSERT INTO `notification_preferences` (`contestant_id`, `content_type`, `in_app`, `web_push`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, NOW(6), NOW(6)) ON DUPLICATE KEY UPDATE `in_app` = VALUES(`in_app`), `web_push` = VALUES(`web_push`), `updated_at` = NOW(6)",
+			contestant.ID,
+			pref.ContentType,
+			pref.InApp,
+			pref.WebPush,
+		)
+		if err != nil {
+			return fmt.Errorf("upsert notification preference: %w", err)
+		}
+	}
+	pbs, err := makeNotificationPreferencePBs(tx, contestant.ID)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return writeProto(e, http.StatusOK, &contestantpb.UpdateNotificationPreferencesResponse{
+		Preferences: pbs,
+	})
+}
+
 func (*ContestantService) Signup(e echo.Context) error {
 	var req contestantpb.SignupRequest
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
 
-	hash := sha256.Sum256([]byte(req.Password))
-	_, err := db.Exec(
+	hash, err := passwordHasher.Hash(req.Password)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+	_, err = db.Exec(
 		"INSERT INTO `contestants` (`id`, `password`, `staff`, `created_at`) VALUES (?, ?, FALSE, NOW(6))",
 		req.ContestantId,
-		hex.EncodeToString(hash[:]),
+		hash,
 	)
 	if mErr, ok := err.(*mysql.MySQLError); ok && mErr.Number == MYSQL_ER_DUP_ENTRY {
 		return halt(e, http.StatusBadRequest, "IDが既に登録されています", nil)
@@ -781,17 +2363,8 @@ func (*ContestantService) Signup(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("insert contestant: %w", err)
 	}
-	sess, err := session.Get(SessionName, e)
-	if err != nil {
-		return fmt.Errorf("get session: %w", err)
-	}
-	sess.Options = &sessions.Options{
-		Path:   "/",
-		MaxAge: 3600,
-	}
-	sess.Values["contestant_id"] = req.ContestantId
-	if err := sess.Save(e.Request(), e.Response()); err != nil {
-		return fmt.Errorf("save session: %w", err)
+	if err := startSession(e, req.ContestantId); err != nil {
+		return err
 	}
 	return writeProto(e, http.StatusOK, &contestantpb.SignupResponse{})
 }
@@ -801,8 +2374,19 @@ func (*ContestantService) Login(e echo.Context) error {
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
+	throttleKeys := []xsuportal.LoginThrottleKey{
+		{Scope: xsuportal.LoginThrottleScopeContestant, Key: req.ContestantId},
+		{Scope: xsuportal.LoginThrottleScopeIP, Key: e.RealIP()},
+	}
+	locked, err := loginThrottle.Locked(db, throttleKeys...)
+	if err != nil {
+		return fmt.Errorf("check login throttle: %w", err)
+	}
+	if locked > 0 {
+		return haltLoginLocked(e, locked)
+	}
 	var password string
-	err := db.Get(
+	err = db.Get(
 		&password,
 		"SELECT `password` FROM `contestants` WHERE `id` = ? LIMIT 1",
 		req.ContestantId,
@@ -810,27 +2394,55 @@ func (*ContestantService) Login(e echo.Context) error {
 	if err != sql.ErrNoRows && err != nil {
 		return fmt.Errorf("get contestant: %w", err)
 	}
-	passwordHash := sha256.Sum256([]byte(req.Password))
-	digest := hex.EncodeToString(passwordHash[:])
-	if err != sql.ErrNoRows && subtle.ConstantTimeCompare([]byte(digest), []byte(password)) == 1 {
-		sess, err := session.Get(SessionName, e)
+	if err == sql.ErrNoRows {
+		// 存在しない ID でも同じくらい時間をかけて、応答時間から ID の有無がわからないようにする
+		password = dummyPasswordHash
+	}
+	ok, needsRehash, verifyErr := passwordHasher.Verify(password, req.Password)
+	if verifyErr != nil {
+		return fmt.Errorf("verify password: %w", verifyErr)
+	}
+	if err == sql.ErrNoRows || !ok {
+		locked, err := loginThrottle.Fail(db, throttleKeys...)
 		if err != nil {
-			return fmt.Errorf("get session: %w", err)
+			return fmt.Errorf("record login failure: %w", err)
 		}
-		sess.Options = &sessions.Options{
-			Path:   "/",
-			MaxAge: 3600,
-		}
-		sess.Values["contestant_id"] = req.ContestantId
-		if err := sess.Save(e.Request(), e.Response()); err != nil {
-			return fmt.Errorf("save session: %w", err)
+		if locked > 0 {
+			return haltLoginLocked(e, locked)
 		}
-	} else {
 		return halt(e, http.StatusBadRequest, "ログインIDまたはパスワードが正しくありません", nil)
 	}
+	if _, err := loginThrottle.Clear(db, throttleKeys[0]); err != nil {
+		return fmt.Errorf("clear login throttle: %w", err)
+	}
+	if needsRehash {
+		hash, err := passwordHasher.Hash(req.Password)
+		if err != nil {
+			return fmt.Errorf("hash password: %w", err)
+		}
+		_, err = db.Exec(
+			"UPDATE `contestants` SET `password` = ? WHERE `id` = ? AND `password` = ? LIMIT 1",
+			hash,
+			req.ContestantId,
+			password,
+		)
+		if err != nil {
+			return fmt.Errorf("update password: %w", err)
+		}
+	}
+	if err := startSession(e, req.ContestantId); err != nil {
+		return err
+	}
 	return writeProto(e, http.StatusOK, &contestantpb.LoginResponse{})
 }
 
+// haltLoginLocked はロック中であることを 429 で返す。パスワード間違いの 400 とはコードで区別できる。
+func haltLoginLocked(e echo.Context, locked time.Duration) error {
+	seconds := int(math.Ceil(locked.Seconds()))
+	e.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+	return halt(e, http.StatusTooManyRequests, fmt.Sprintf("ログインの失敗が続いたため、%d 秒間ログインできません", seconds), nil)
+}
+
 func (*ContestantService) Logout(e echo.Context) error {
 	sess, err := session.Get(SessionName, e)
 	if err != nil {
@@ -838,10 +2450,7 @@ func (*ContestantService) Logout(e echo.Context) error {
 	}
 	if _, ok := sess.Values["contestant_id"]; ok {
 		delete(sess.Values, "contestant_id")
-		sess.Options = &sessions.Options{
-			Path:   "/",
-			MaxAge: -1,
-		}
+		sess.Options.MaxAge = -1
 		if err := sess.Save(e.Request(), e.Response()); err != nil {
 			return fmt.Errorf("delete session: %w", err)
 		}
@@ -868,11 +2477,16 @@ func (*RegistrationService) GetRegistrationSession(e echo.Context) error {
 			if err != nil {
 				return fmt.Errorf("parse team id: %w", err)
 			}
+			contestStatus, err := getCurrentContestStatus(e, db)
+			if err != nil {
+				return fmt.Errorf("get current contest status: %w", err)
+			}
 			var t xsuportal.Team
 			err = db.Get(
 				&t,
-				"SELECT * FROM `teams` WHERE `id` = ? AND `invite_token` = ? AND `withdrawn` = FALSE LIMIT 1",
+				"SELECT * FROM `teams` WHERE `id` = ? AND `contest_id` = ? AND `invite_token` = ? AND `withdrawn` = FALSE LIMIT 1",
 				teamID,
+				contestStatus.ID,
 				inviteToken,
 			)
 			if err == sql.ErrNoRows {
@@ -905,7 +2519,7 @@ func (*RegistrationService) GetRegistrationSession(e echo.Context) error {
 		return fmt.Errorf("get current contestant: %w", err)
 	}
 	switch {
-	case contestant != nil && contestant.TeamID.Valid:
+	case contestant != nil && currentTeam != nil:
 		res.Status = registrationpb.GetRegistrationSessionResponse_JOINED
 	case team != nil && len(members) >= 3:
 		res.Status = registrationpb.GetRegistrationSessionResponse_NOT_JOINABLE
@@ -934,27 +2548,36 @@ func (*RegistrationService) CreateTeam(e echo.Context) error {
 	if err := e.Bind(&req); err != nil {
 		return err
 	}
-	if ok, err := loginRequired(e, db, &loginRequiredOption{}); !ok {
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if ok, err := loginRequired(e, tx, &loginRequiredOption{Lock: true}); !ok {
 		return wrapError("check session", err)
 	}
-	ok, err := contestStatusRestricted(e, db, resourcespb.Contest_REGISTRATION, "チーム登録期間ではありません")
+	ok, err := contestStatusRestricted(e, tx, resourcespb.Contest_REGISTRATION, "チーム登録期間ではありません")
 	if !ok {
 		return wrapError("check contest status", err)
 	}
-
-	ctx := context.Background()
-	conn, err := db.Connx(ctx)
+	contestStatus, err := getCurrentContestStatus(e, tx)
 	if err != nil {
-		return fmt.Errorf("get conn: %w", err)
+		return fmt.Errorf("get current contest status: %w", err)
 	}
-	defer conn.Close()
-
-	// TODO: LOCK TABLESやばくない？
-	_, err = conn.ExecContext(ctx, "LOCK TABLES `teams` WRITE, `contestants` WRITE")
+	// 同じコンテストのチーム登録をコンテストの行のロックで直列にして、上限を超えて登録されないようにする
+	var lockedContestID int64
+	err = tx.Get(&lockedContestID, "SELECT `id` FROM `contests` WHERE `id` = ? LIMIT 1 FOR UPDATE", contestStatus.ID)
 	if err != nil {
-		return fmt.Errorf("lock tables: %w", err)
+		return fmt.Errorf("lock contest: %w", err)
+	}
+	currentTeam, err := getCurrentTeam(e, tx, false)
+	if err != nil {
+		return fmt.Errorf("get current team: %w", err)
+	}
+	if currentTeam != nil {
+		return halt(e, http.StatusBadRequest, "すでにチームに所属しています", nil)
 	}
-	defer conn.ExecContext(ctx, "UNLOCK TABLES")
 
 	randomBytes := make([]byte, 64)
 	_, err = rand.Read(randomBytes)
@@ -964,20 +2587,21 @@ func (*RegistrationService) CreateTeam(e echo.Context) error {
 	inviteToken := base64.URLEncoding.EncodeToString(randomBytes)
 	// TODO: 数は手元で持ってもよさそう
 	var withinCapacity bool
-	err = conn.QueryRowContext(
-		ctx,
-		"SELECT COUNT(*) < ? AS `within_capacity` FROM `teams`",
+	err = tx.Get(
+		&withinCapacity,
+		"SELECT COUNT(*) < ? AS `within_capacity` FROM `teams` WHERE `contest_id` = ?",
 		TeamCapacity,
-	).Scan(&withinCapacity)
+		contestStatus.ID,
+	)
 	if err != nil {
 		return fmt.Errorf("check capacity: %w", err)
 	}
 	if !withinCapacity {
 		return halt(e, http.StatusForbidden, "チーム登録数上限です", nil)
 	}
-	res, err := conn.ExecContext(
-		ctx,
-		"INSERT INTO `teams` (`name`, `email_address`, `invite_token`, `created_at`) VALUES (?, ?, ?, NOW(6))",
+	res, err := tx.Exec(
+		"INSERT INTO `teams` (`contest_id`, `name`, `email_address`, `invite_token`, `created_at`) VALUES (?, ?, ?, ?, NOW(6))",
+		contestStatus.ID,
 		req.TeamName,
 		req.EmailAddress,
 		inviteToken,
@@ -990,10 +2614,9 @@ func (*RegistrationService) CreateTeam(e echo.Context) error {
 		return halt(e, http.StatusInternalServerError, "チームを登録できませんでした", nil)
 	}
 
-	contestant, _ := getCurrentContestant(e, db, false)
+	contestant, _ := getCurrentContestant(e, tx, false)
 
-	_, err = conn.ExecContext(
-		ctx,
+	_, err = tx.Exec(
 		"UPDATE `contestants` SET `name` = ?, `student` = ?, `team_id` = ? WHERE id = ? LIMIT 1",
 		req.Name,
 		req.IsStudent,
@@ -1004,8 +2627,7 @@ func (*RegistrationService) CreateTeam(e echo.Context) error {
 		return fmt.Errorf("update contestant: %w", err)
 	}
 
-	_, err = conn.ExecContext(
-		ctx,
+	_, err = tx.Exec(
 		"UPDATE `teams` SET `leader_id` = ? WHERE `id` = ? LIMIT 1",
 		contestant.ID,
 		teamID,
@@ -1013,6 +2635,9 @@ func (*RegistrationService) CreateTeam(e echo.Context) error {
 	if err != nil {
 		return fmt.Errorf("update team: %w", err)
 	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
 
 	return writeProto(e, http.StatusOK, &registrationpb.CreateTeamResponse{
 		TeamId: teamID,
@@ -1036,11 +2661,23 @@ func (*RegistrationService) JoinTeam(e echo.Context) error {
 	if ok, err := contestStatusRestricted(e, tx, resourcespb.Contest_REGISTRATION, "チーム登録期間ではありません"); !ok {
 		return wrapError("check contest status", err)
 	}
+	contestStatus, err := getCurrentContestStatus(e, tx)
+	if err != nil {
+		return fmt.Errorf("get current contest status: %w", err)
+	}
+	currentTeam, err := getCurrentTeam(e, tx, false)
+	if err != nil {
+		return fmt.Errorf("get current team: %w", err)
+	}
+	if currentTeam != nil {
+		return halt(e, http.StatusBadRequest, "すでにチームに所属しています", nil)
+	}
 	var team xsuportal.Team
 	err = tx.Get(
 		&team,
-		"SELECT * FROM `teams` WHERE `id` = ? AND `invite_token` = ? AND `withdrawn` = FALSE LIMIT 1 FOR UPDATE",
+		"SELECT * FROM `teams` WHERE `id` = ? AND `contest_id` = ? AND `invite_token` = ? AND `withdrawn` = FALSE LIMIT 1 FOR UPDATE",
 		req.TeamId,
+		contestStatus.ID,
 		req.InviteToken,
 	)
 	if err == sql.ErrNoRows {
@@ -1175,8 +2812,12 @@ func (*RegistrationService) DeleteRegistration(e echo.Context) error {
 type AudienceService struct{}
 
 func (*AudienceService) ListTeams(e echo.Context) error {
+	contestStatus, err := getCurrentContestStatus(e, db)
+	if err != nil {
+		return fmt.Errorf("get current contest status: %w", err)
+	}
 	var teams []xsuportal.Team
-	err := db.Select(&teams, "SELECT * FROM `teams` WHERE `withdrawn` = FALSE ORDER BY `created_at` DESC")
+	err = db.Select(&teams, "SELECT * FROM `teams` WHERE `contest_id` = ? AND `withdrawn` = FALSE ORDER BY `created_at` DESC", contestStatus.ID)
 	if err != nil {
 		return fmt.Errorf("select teams: %w", err)
 	}
@@ -1218,7 +2859,7 @@ func (*AudienceService) Dashboard(e echo.Context) error {
 		return e.Blob(http.StatusOK, "application/vnd.google.protobuf", c.([]byte))
 	}
 
-	res, err := makeLeaderboardPB(e, 0)
+	res, err := makeLeaderboardPB(e, 0, false)
 	if err != nil {
 		return fmt.Errorf("make leaderboard: %w", err)
 	}
@@ -1231,8 +2872,111 @@ func (*AudienceService) Dashboard(e echo.Context) error {
 }
 
 type XsuportalContext struct {
-	Contestant *xsuportal.Contestant
-	Team       *xsuportal.Team
+	Contestant    *xsuportal.Contestant
+	Team          *xsuportal.Team
+	Staff         *xsuportal.Staff
+	ContestStatus *xsuportal.ContestStatus
+}
+
+// staffRequired はログイン中のコンテスタントがスタッフでなければ 403 を返す。/api/admin 以下の全ハンドラの前に通す。
+func staffRequired(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		contestant, err := getCurrentContestant(e, db, false)
+		if err != nil {
+			return wrapError("check session", err)
+		}
+		if ok, err := loginRequiredByContestant(e, contestant, &loginRequiredOption{}); !ok {
+			return wrapError("check session", err)
+		}
+		staff, err := getCurrentStaff(e, db)
+		if err != nil {
+			return err
+		}
+		if staff == nil {
+			return halt(e, http.StatusForbidden, "管理者権限が必要です", nil)
+		}
+		return next(e)
+	}
+}
+
+// initializeAllowed は INITIALIZE_TOKEN を Bearer トークンで渡すか、contest_operator のスタッフでなければ 403 を返す。
+// 最初の初期化ではまだスタッフがいないので、INITIALIZE_TOKEN を設定しておかないと初期化できない。
+func initializeAllowed(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		if token := util.GetEnv("INITIALIZE_TOKEN", ""); token != "" {
+			given := strings.TrimPrefix(e.Request().Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1 {
+				return next(e)
+			}
+		}
+		staff, err := getCurrentStaff(e, db)
+		if err != nil {
+			return err
+		}
+		if staff.Can(xsuportal.StaffRoleContestOperator) {
+			return next(e)
+		}
+		if util.GetEnv("INITIALIZE_TOKEN", "") == "" {
+			return halt(e, http.StatusForbidden, "INITIALIZE_TOKEN が設定されていないため、contest_operator 以上のロールがなければ初期化できません", nil)
+		}
+		return halt(e, http.StatusForbidden, "初期化には INITIALIZE_TOKEN か contest_operator 以上のロールが必要です", nil)
+	}
+}
+
+// staffRoleRequired はスタッフが role 以上のロールを持っていなければ 403 を返す。staffRequired の後に通すこと。
+func staffRoleRequired(role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(e echo.Context) error {
+			if !getXsuportalContext(e).Staff.Can(role) {
+				return halt(e, http.StatusForbidden, fmt.Sprintf("%s 以上のロールが必要です", role), nil)
+			}
+			return next(e)
+		}
+	}
+}
+
+// getSessionSecrets は SESSION_SECRETS (カンマ区切り、先頭が署名用) を返す。
+// 未設定なら起動ごとにランダムな鍵を作るので、再起動するとログイン中のセッションは切れる。
+func getSessionSecrets() [][]byte {
+	var secrets [][]byte
+	for _, secret := range strings.Split(util.GetEnv("SESSION_SECRETS", ""), ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			secrets = append(secrets, []byte(secret))
+		}
+	}
+	if len(secrets) == 0 {
+		log.Printf("[WARN] SESSION_SECRETS is not set; using a random secret")
+		secrets = append(secrets, securecookie.GenerateRandomKey(32))
+	}
+	return secrets
+}
+
+// touchSession はログイン中のセッションの有効期限をアクセスのたびに延長する
+func touchSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		sess, err := session.Get(SessionName, e)
+		if err == nil {
+			if _, ok := sess.Values["contestant_id"]; ok {
+				if err := sessionStore.Touch(e.Request(), e.Response(), sess); err != nil {
+					return fmt.Errorf("touch session: %w", err)
+				}
+			}
+		}
+		return next(e)
+	}
+}
+
+// startSession はセッション ID を振り直してから contestantID でログインさせる。
+func startSession(e echo.Context, contestantID string) error {
+	sess, err := session.Get(SessionName, e)
+	if err != nil {
+		return fmt.Errorf("get session: %w", err)
+	}
+	sess.Values["contestant_id"] = contestantID
+	if err := sessionStore.Renew(e.Request(), e.Response(), sess); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+	return nil
 }
 
 func getXsuportalContext(e echo.Context) *XsuportalContext {
@@ -1273,6 +3017,23 @@ func getCurrentContestant(e echo.Context, db sqlx.Queryer, lock bool) (*xsuporta
 	return xc.Contestant, nil
 }
 
+func getCurrentStaff(e echo.Context, db sqlx.Queryer) (*xsuportal.Staff, error) {
+	xc := getXsuportalContext(e)
+	if xc.Staff != nil {
+		return xc.Staff, nil
+	}
+	contestant, err := getCurrentContestant(e, db, false)
+	if err != nil {
+		return nil, fmt.Errorf("current contestant: %w", err)
+	}
+	staff, err := xsuportal.GetStaff(db, contestant)
+	if err != nil {
+		return nil, err
+	}
+	xc.Staff = staff
+	return xc.Staff, nil
+}
+
 func getCurrentTeam(e echo.Context, db sqlx.Queryer, lock bool) (*xsuportal.Team, error) {
 	xc := getXsuportalContext(e)
 	if xc.Team != nil {
@@ -1282,15 +3043,20 @@ func getCurrentTeam(e echo.Context, db sqlx.Queryer, lock bool) (*xsuportal.Team
 	if err != nil {
 		return nil, fmt.Errorf("current contestant: %w", err)
 	}
-	if contestant == nil {
+	if contestant == nil || !contestant.TeamID.Valid {
 		return nil, nil
 	}
+	contestStatus, err := getCurrentContestStatus(e, db)
+	if err != nil {
+		return nil, fmt.Errorf("current contest status: %w", err)
+	}
+	// 以前のコンテストのチームに所属したままなら、アクティブなコンテストには未登録として扱う
 	var team xsuportal.Team
-	query := "SELECT * FROM `teams` WHERE `id` = ? LIMIT 1"
+	query := "SELECT * FROM `teams` WHERE `id` = ? AND `contest_id` = ? LIMIT 1"
 	if lock {
 		query += " FOR UPDATE"
 	}
-	err = sqlx.Get(db, &team, query, contestant.TeamID.Int64)
+	err = sqlx.Get(db, &team, query, contestant.TeamID.Int64, contestStatus.ID)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -1301,32 +3067,46 @@ func getCurrentTeam(e echo.Context, db sqlx.Queryer, lock bool) (*xsuportal.Team
 	return xc.Team, nil
 }
 
+// getCurrentContestStatus はアクティブなコンテストの進行状況を返す。リクエストの間は同じ結果を使い回す。
 func getCurrentContestStatus(e echo.Context, db sqlx.Queryer) (*xsuportal.ContestStatus, error) {
-	var contestStatus xsuportal.ContestStatus
-	err := sqlx.Get(db, &contestStatus, "SELECT *, NOW(6) AS `current_time`, CASE WHEN NOW(6) < `registration_open_at` THEN 'standby' WHEN `registration_open_at` <= NOW(6) AND NOW(6) < `contest_starts_at` THEN 'registration' WHEN `contest_starts_at` <= NOW(6) AND NOW(6) < `contest_ends_at` THEN 'started' WHEN `contest_ends_at` <= NOW(6) THEN 'finished' ELSE 'unknown' END AS `status`, IF(`contest_starts_at` <= NOW(6) AND NOW(6) < `contest_freezes_at`, 1, 0) AS `frozen` FROM `contest_config`")
+	xc := getXsuportalContext(e)
+	if xc.ContestStatus != nil {
+		return xc.ContestStatus, nil
+	}
+	contestStatus, err := xsuportal.GetContestStatus(db, clock)
 	if err != nil {
-		return nil, fmt.Errorf("query contest status: %w", err)
+		return nil, fmt.Errorf("get contest status: %w", err)
 	}
-	statusStr := contestStatus.StatusStr
-	if e.Echo().Debug {
-		b, err := ioutil.ReadFile(DebugContestStatusFilePath)
-		if err == nil {
-			statusStr = string(b)
-		}
-	}
-	switch statusStr {
-	case "standby":
-		contestStatus.Status = resourcespb.Contest_STANDBY
-	case "registration":
-		contestStatus.Status = resourcespb.Contest_REGISTRATION
-	case "started":
-		contestStatus.Status = resourcespb.Contest_STARTED
-	case "finished":
-		contestStatus.Status = resourcespb.Contest_FINISHED
-	default:
-		return nil, fmt.Errorf("unexpected contest status: %q", contestStatus.StatusStr)
+	xc.ContestStatus = contestStatus
+	return xc.ContestStatus, nil
+}
+
+// getAdminContestID は ?contest_id= で指定されたコンテスト (アーカイブ済みも含む) か、なければアクティブなコンテストの ID を返す。
+func getAdminContestID(e echo.Context) (int64, error) {
+	if v := e.QueryParam("contest_id"); v != "" {
+		contestID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, halt(e, http.StatusBadRequest, "contest_id が不正です", nil)
+		}
+		return contestID, nil
+	}
+	contestStatus, err := getCurrentContestStatus(e, db)
+	if err != nil {
+		return 0, fmt.Errorf("get current contest status: %w", err)
+	}
+	return contestStatus.ID, nil
+}
+
+// contestWritable は contestID のコンテストがアーカイブ済みなら 403 を返す。
+func contestWritable(e echo.Context, db sqlx.Queryer, contestID int64) (bool, error) {
+	err := xsuportal.CheckContestWritable(db, contestID)
+	if errors.Is(err, xsuportal.ErrContestArchived) {
+		return false, halt(e, http.StatusForbidden, "アーカイブ済みのコンテストは変更できません", nil)
+	}
+	if err != nil {
+		return false, fmt.Errorf("check contest writable: %w", err)
 	}
-	return &contestStatus, nil
+	return true, nil
 }
 
 type loginRequiredOption struct {
@@ -1387,6 +3167,31 @@ func writeProto(e echo.Context, code int, m proto.Message) error {
 	return e.Blob(code, "application/vnd.google.protobuf", res)
 }
 
+func startEventStream(e echo.Context) {
+	h := e.Response().Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+	e.Response().WriteHeader(http.StatusOK)
+	e.Response().Flush()
+}
+
+// writeEvent は m を protobuf にして base64 にしたものを data として Server-Sent Events を 1 件書く
+func writeEvent(e echo.Context, id string, event string, m proto.Message) error {
+	b, _ := proto.Marshal(m)
+	var buf strings.Builder
+	if id != "" {
+		buf.WriteString("id: " + id + "\n")
+	}
+	buf.WriteString("event: " + event + "\n")
+	buf.WriteString("data: " + base64.StdEncoding.EncodeToString(b) + "\n\n")
+	if _, err := e.Response().Write([]byte(buf.String())); err != nil {
+		return err
+	}
+	e.Response().Flush()
+	return nil
+}
+
 func halt(e echo.Context, code int, humanMessage string, err error) error {
 	message := &xsuportalpb.Error{
 		Code: int32(code),
@@ -1478,17 +3283,53 @@ func makeContestPB(e echo.Context) (*resourcespb.Contest, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get current contest status: %w", err)
 	}
-	return &resourcespb.Contest{
+	return makeContestStatusPB(contestStatus), nil
+}
+
+func makeContestStatusPB(contestStatus *xsuportal.ContestStatus) *resourcespb.Contest {
+	pb := &resourcespb.Contest{
+		Id:                 contestStatus.ID,
+		Name:               contestStatus.Name,
 		RegistrationOpenAt: timestamppb.New(contestStatus.RegistrationOpenAt),
 		ContestStartsAt:    timestamppb.New(contestStatus.ContestStartsAt),
 		ContestFreezesAt:   timestamppb.New(contestStatus.ContestFreezesAt),
 		ContestEndsAt:      timestamppb.New(contestStatus.ContestEndsAt),
 		Status:             contestStatus.Status,
 		Frozen:             contestStatus.Frozen,
-	}, nil
+		Active:             contestStatus.Active,
+	}
+	if contestStatus.ArchivedAt.Valid {
+		pb.ArchivedAt = timestamppb.New(contestStatus.ArchivedAt.Time)
+	}
+	return pb
+}
+
+func makeStaffPB(staff *xsuportal.Staff) *resourcespb.Staff {
+	return &resourcespb.Staff{
+		Id:           staff.ID,
+		GithubLogin:  staff.GithubLogin.String,
+		ContestantId: staff.ContestantID,
+		Role:         staff.Role,
+	}
+}
+
+func makeClockPB() *adminpb.Clock {
+	pb := &adminpb.Clock{
+		Rehearsal: rehearsalClock != nil,
+		Now:       timestamppb.New(clock.Now()),
+	}
+	if rehearsalClock != nil {
+		offset, fixedAt := rehearsalClock.Setting()
+		pb.OffsetSeconds = offset.Seconds()
+		if fixedAt.Valid {
+			pb.FixedAt = timestamppb.New(fixedAt.Time)
+		}
+	}
+	return pb
 }
 
-func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
+// unfrozen が true のときは凍結を無視した運営向けのリーダーボードを返す。観客向けのキャッシュとは混ざらない。
+func makeLeaderboardPB(e echo.Context, teamID int64, unfrozen bool) ([]byte, error) {
 	contestStatus, err := getCurrentContestStatus(e, db)
 	if err != nil {
 		return nil, fmt.Errorf("get current contest status: %w", err)
@@ -1496,14 +3337,17 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 	contestFinished := contestStatus.Status == resourcespb.Contest_FINISHED
 	contestFreezesAt := contestStatus.ContestFreezesAt
 
-	isSame := teamID == 0 || contestFinished || contestFreezesAt.Before(time.Now())
+	isSame := unfrozen || teamID == 0 || contestFinished || contestFreezesAt.Before(contestStatus.CurrentTime)
 
-	name := strconv.FormatBool(contestFinished) + contestFreezesAt.Format(time.Stamp)
-	if !isSame {
-		name = strconv.FormatBool(contestFinished) + contestFreezesAt.Format(time.Stamp) + strconv.FormatInt(teamID, 10)
+	name := strconv.FormatInt(contestStatus.ID, 10) + strconv.FormatBool(contestFinished) + contestFreezesAt.Format(time.Stamp)
+	if unfrozen {
+		name = AdminDashBoardCacheKey
+	} else if !isSame {
+		name = strconv.FormatInt(contestStatus.ID, 10) + strconv.FormatBool(contestFinished) + contestFreezesAt.Format(time.Stamp) + strconv.FormatInt(teamID, 10)
 	}
 
-	v, err, _ := dashboardGroup.Do(name, func() (interface{}, error) {
+	generation := atomic.LoadInt64(&dashboardGeneration)
+	v, err, _ := dashboardGroup.Do(strconv.FormatInt(generation, 10)+":"+name, func() (interface{}, error) {
 		tx, err := db.Beginx()
 		if err != nil {
 			return nil, fmt.Errorf("begin tx: %w", err)
@@ -1577,10 +3421,12 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 				"    GROUP BY\n" +
 				"      `contestants`.`team_id`\n" +
 				"  ) `team_student_flags` ON `team_student_flags`.`team_id` = `teams`.`id`\n" +
+				"WHERE\n" +
+				"  `teams`.`contest_id` = ?\n" +
 				"ORDER BY\n" +
 				"  `latest_score` DESC,\n" +
 				"  `latest_score_marked_at` ASC\n"
-			err = tx.Select(&leaderboard, query)
+			err = tx.Select(&leaderboard, query, contestStatus.ID)
 			if err != sql.ErrNoRows && err != nil {
 				return nil, fmt.Errorf("select leaderboard: %w", err)
 			}
@@ -1593,13 +3439,14 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 				"FROM\n" +
 				"  `benchmark_jobs`\n" +
 				"WHERE\n" +
-				"  `started_at` IS NOT NULL\n" +
+				"  `contest_id` = ?\n" +
+				"  AND `started_at` IS NOT NULL\n" +
 				"  AND (\n" +
 				"    `finished_at` IS NOT NULL\n" +
 				"  )\n" +
 				"ORDER BY\n" +
 				"  `finished_at`"
-			err = tx.Select(&jobResults, jobResultsQuery)
+			err = tx.Select(&jobResults, jobResultsQuery, contestStatus.ID)
 			if err != sql.ErrNoRows && err != nil {
 				return nil, fmt.Errorf("select job results: %w", err)
 			}
@@ -1670,10 +3517,12 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 				"    GROUP BY\n" +
 				"      `contestants`.`team_id`\n" +
 				"  ) `team_student_flags` ON `team_student_flags`.`team_id` = `teams`.`id`\n" +
+				"WHERE\n" +
+				"  `teams`.`contest_id` = ?\n" +
 				"ORDER BY\n" +
 				"  `latest_score` DESC,\n" +
 				"  `latest_score_marked_at` ASC\n"
-			err = tx.Select(&leaderboard, query, teamID, teamID, contestFinished, contestFreezesAt, teamID, teamID, contestFinished, contestFreezesAt)
+			err = tx.Select(&leaderboard, query, teamID, teamID, contestFinished, contestFreezesAt, teamID, teamID, contestFinished, contestFreezesAt, contestStatus.ID)
 			if err != sql.ErrNoRows && err != nil {
 				return nil, fmt.Errorf("select leaderboard: %w", err)
 			}
@@ -1686,7 +3535,8 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 				"FROM\n" +
 				"  `benchmark_jobs`\n" +
 				"WHERE\n" +
-				"  `started_at` IS NOT NULL\n" +
+				"  `contest_id` = ?\n" +
+				"  AND `started_at` IS NOT NULL\n" +
 				"  AND (\n" +
 				"    `finished_at` IS NOT NULL\n" +
 				"    -- score freeze\n" +
@@ -1694,7 +3544,7 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 				"  )\n" +
 				"ORDER BY\n" +
 				"  `finished_at`"
-			err = tx.Select(&jobResults, jobResultsQuery, teamID, teamID, contestFinished, contestFreezesAt)
+			err = tx.Select(&jobResults, jobResultsQuery, contestStatus.ID, teamID, teamID, contestFinished, contestFreezesAt)
 			if err != sql.ErrNoRows && err != nil {
 				return nil, fmt.Errorf("select job results: %w", err)
 			}
@@ -1737,18 +3587,27 @@ func makeLeaderboardPB(e echo.Context, teamID int64) ([]byte, error) {
 		}
 		return pb, nil
 	})
-	pb := v.(*resourcespb.Leaderboard)
-
 	if err != nil {
 		return nil, err
 	}
+	pb := v.(*resourcespb.Leaderboard)
+
+	if unfrozen {
+		res, _ := proto.Marshal(&adminpb.DashboardResponse{
+			Leaderboard: pb,
+		})
+		if atomic.LoadInt64(&dashboardGeneration) == generation {
+			cacheStore.Set(AdminDashBoardCacheKey, res, 0)
+		}
+		return res, nil
+	}
 
 	// TODO: sync.Poolでbyte使いまわす
 	res, _ := proto.Marshal(&audiencepb.DashboardResponse{
 		Leaderboard: pb,
 	})
 
-	if isSame && err == nil {
+	if isSame && err == nil && atomic.LoadInt64(&dashboardGeneration) == generation {
 		cacheStore.Set(AudienceDashBoardCacheKey, res, 0)
 	}
 	return res, err
@@ -1773,6 +3632,20 @@ func makeBenchmarkJobPB(job *xsuportal.BenchmarkJob) *resourcespb.BenchmarkJob {
 	return pb
 }
 
+func makeAdminBenchmarkJobPB(db sqlx.Queryer, job *xsuportal.BenchmarkJob) (*resourcespb.BenchmarkJob, error) {
+	pb := makeBenchmarkJobPB(job)
+	var team xsuportal.Team
+	if err := sqlx.Get(db, &team, "SELECT * FROM `teams` WHERE `id` = ? LIMIT 1", job.TeamID); err != nil {
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	t, err := makeTeamPB(db, &team, false, true)
+	if err != nil {
+		return nil, fmt.Errorf("make team: %w", err)
+	}
+	pb.Team = t
+	return pb, nil
+}
+
 func makeBenchmarkResultPB(job *xsuportal.BenchmarkJob) *resourcespb.BenchmarkResult {
 	hasScore := job.ScoreRaw.Valid && job.ScoreDeduction.Valid
 	pb := &resourcespb.BenchmarkResult{
@@ -1790,6 +3663,20 @@ func makeBenchmarkResultPB(job *xsuportal.BenchmarkJob) *resourcespb.BenchmarkRe
 	return pb
 }
 
+func makeBenchmarkProgressPB(p *xsuportal.BenchmarkJobProgress) *resourcespb.BenchmarkResult {
+	pb := &resourcespb.BenchmarkResult{
+		MarkedAt: timestamppb.New(p.MarkedAt),
+	}
+	if p.ScoreRaw.Valid && p.ScoreDeduction.Valid {
+		pb.Score = int64(p.ScoreRaw.Int32 - p.ScoreDeduction.Int32)
+		pb.ScoreBreakdown = &resourcespb.BenchmarkResult_ScoreBreakdown{
+			Raw:       int64(p.ScoreRaw.Int32),
+			Deduction: int64(p.ScoreDeduction.Int32),
+		}
+	}
+	return pb
+}
+
 func makeBenchmarkJobsPB(e echo.Context, db sqlx.Queryer, limit int) ([]*resourcespb.BenchmarkJob, error) {
 	team, _ := getCurrentTeam(e, db, false)
 	query := "SELECT * FROM `benchmark_jobs` WHERE `team_id` = ? ORDER BY `created_at` DESC"
@@ -1807,6 +3694,21 @@ func makeBenchmarkJobsPB(e echo.Context, db sqlx.Queryer, limit int) ([]*resourc
 	return benchmarkJobs, nil
 }
 
+func countUnreadNotifications(db sqlx.Queryer, contestantID string, contestID int64) (int64, error) {
+	var count int64
+	err := sqlx.Get(
+		db,
+		&count,
+		"SELECT COUNT(*) FROM `notifications` WHERE `contestant_id` = ? AND `contest_id` = ? AND `read` = FALSE",
+		contestantID,
+		contestID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
 func makeNotificationsPB(notifications []*xsuportal.Notification) ([]*resourcespb.Notification, error) {
 	var ns []*resourcespb.Notification
 	for _, notification := range notifications {
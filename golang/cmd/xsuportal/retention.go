@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const NotificationPruneBatchSize = 1000
+
+// notificationPruner は Retention より前に作られた既読の通知を定期的に削除する。
+// after カーソルは id の大小だけで決まるので、行を消しても既存のカーソルはそのまま使える。
+// ただし AUTO_INCREMENT が再起動後に MAX(id) から振り直されて id が再利用されないよう、最新の 1 件は残す。
+type notificationPruner struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+func (p *notificationPruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		n, err := p.prune()
+		if err != nil {
+			log.Printf("[WARN] prune notifications: %v", err)
+		}
+		if n > 0 {
+			log.Printf("[INFO] pruned %d notifications", n)
+		}
+	}
+}
+
+func (p *notificationPruner) prune() (int64, error) {
+	var maxID int64
+	err := db.Get(&maxID, "SELECT COALESCE(MAX(`id`), 0) FROM `notifications`")
+	if err != nil {
+		return 0, fmt.Errorf("get max notification id: %w", err)
+	}
+	// 通知の created_at はコンテストの時計で記録されているので、境界も同じ時計で決める
+	threshold := clock.Now().Add(-p.Retention)
+	var total int64
+	for {
+		res, err := db.Exec(
+			"DELETE FROM `notifications` WHERE `read` = TRUE AND `created_at` < ? AND `id` < ? ORDER BY `id` LIMIT ?",
+			threshold,
+			maxID,
+			NotificationPruneBatchSize,
+		)
+		if err != nil {
+			return total, fmt.Errorf("delete notifications: %w", err)
+		}
+		n, _ := res.RowsAffected()
+		total += n
+		if n < NotificationPruneBatchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+
+	xsuportal "github.com/isucon/isucon10-final/webapp/golang"
+)
+
+type fixedClock struct{ t time.Time }
+
+func (c fixedClock) Now() time.Time {
+	return c.t
+}
+
+func (c fixedClock) At(time.Time) time.Time {
+	return c.t
+}
+
+type notificationRow struct {
+	ID        int64
+	Read      bool
+	CreatedAt time.Time
+}
+
+// deleteResult は prune の DELETE と同じ条件で rows から最大 limit 件を id の小さい順に消し、消した件数を返す。
+type deleteResult struct {
+	rows      *[]notificationRow
+	threshold time.Time
+	maxID     int64
+	limit     int64
+}
+
+func (r *deleteResult) LastInsertId() (int64, error) {
+	return 0, nil
+}
+
+func (r *deleteResult) RowsAffected() (int64, error) {
+	var n int64
+	kept := (*r.rows)[:0]
+	for _, row := range *r.rows {
+		if n < r.limit && row.Read && row.CreatedAt.Before(r.threshold) && row.ID < r.maxID {
+			n++
+			continue
+		}
+		kept = append(kept, row)
+	}
+	*r.rows = kept
+	return n, nil
+}
+
+func TestNotificationPrunerPrune(t *testing.T) {
+	now := time.Date(2020, 10, 3, 12, 0, 0, 0, time.UTC)
+	retention := time.Hour
+	old := now.Add(-2 * time.Hour)
+	recent := now.Add(-time.Minute)
+
+	manyOldRows := make([]notificationRow, 0, NotificationPruneBatchSize+2)
+	for id := int64(1); id <= NotificationPruneBatchSize+2; id++ {
+		manyOldRows = append(manyOldRows, notificationRow{ID: id, Read: true, CreatedAt: old})
+	}
+
+	tests := []struct {
+		name    string
+		rows    []notificationRow
+		deletes int
+		pruned  int64
+		kept    []int64
+	}{
+		{
+			name: "prunes old read rows",
+			rows: []notificationRow{
+				{ID: 1, Read: true, CreatedAt: old},
+				{ID: 2, Read: true, CreatedAt: old},
+				{ID: 3, Read: true, CreatedAt: recent},
+			},
+			deletes: 1,
+			pruned:  2,
+			kept:    []int64{3},
+		},
+		{
+			name: "keeps unread rows",
+			rows: []notificationRow{
+				{ID: 1, Read: false, CreatedAt: old},
+				{ID: 2, Read: true, CreatedAt: old},
+				{ID: 3, Read: false, CreatedAt: recent},
+				{ID: 4, Read: true, CreatedAt: recent},
+			},
+			deletes: 1,
+			pruned:  1,
+			kept:    []int64{1, 3, 4},
+		},
+		{
+			name: "keeps the newest id",
+			rows: []notificationRow{
+				{ID: 1, Read: true, CreatedAt: old},
+				{ID: 2, Read: true, CreatedAt: old},
+			},
+			deletes: 1,
+			pruned:  1,
+			kept:    []int64{2},
+		},
+		{
+			name:    "deletes in batches",
+			rows:    manyOldRows,
+			deletes: 2,
+			pruned:  NotificationPruneBatchSize + 1,
+			kept:    []int64{NotificationPruneBatchSize + 2},
+		},
+		{
+			name:    "empty",
+			deletes: 1,
+			pruned:  0,
+			kept:    []int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer sqlDB.Close()
+			defer func(d *sqlx.DB, c xsuportal.Clock) { db, clock = d, c }(db, clock)
+			db = sqlx.NewDb(sqlDB, "mysql")
+			clock = fixedClock{now}
+
+			rows := append([]notificationRow(nil), tt.rows...)
+			var maxID int64
+			for _, row := range rows {
+				if row.ID > maxID {
+					maxID = row.ID
+				}
+			}
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(`id`), 0) FROM `notifications`")).
+				WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(maxID))
+			threshold := now.Add(-retention)
+			for i := 0; i < tt.deletes; i++ {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `notifications` WHERE `read` = TRUE AND `created_at` < ? AND `id` < ? ORDER BY `id` LIMIT ?")).
+					WithArgs(threshold, maxID, NotificationPruneBatchSize).
+					WillReturnResult(&deleteResult{rows: &rows, threshold: threshold, maxID: maxID, limit: NotificationPruneBatchSize})
+			}
+
+			p := &notificationPruner{Retention: retention}
+			n, err := p.prune()
+			if err != nil {
+				t.Fatalf("prune: %v", err)
+			}
+			if n != tt.pruned {
+				t.Errorf("pruned = %d, want %d", n, tt.pruned)
+			}
+			kept := make([]int64, 0, len(rows))
+			for _, row := range rows {
+				kept = append(kept, row.ID)
+			}
+			if len(kept) != len(tt.kept) {
+				t.Fatalf("kept = %v, want %v", kept, tt.kept)
+			}
+			for i := range kept {
+				if kept[i] != tt.kept[i] {
+					t.Fatalf("kept = %v, want %v", kept, tt.kept)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package xsuportal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+// ErrContestArchived はアーカイブ済みのコンテストのデータを変更しようとしたときに返す。
+var ErrContestArchived = errors.New("contest is archived")
+
+// アクティブなコンテストは常に高々 1 つで、参加登録・ベンチマーク・質問はすべてアクティブなコンテストに対して行う。
+// アーカイブ済みのコンテストはアクティブにできず、データは閲覧だけできる。
+func GetActiveContest(db sqlx.Queryer) (*Contest, error) {
+	var contest Contest
+	err := sqlx.Get(db, &contest, "SELECT * FROM `contests` WHERE `active` = TRUE LIMIT 1")
+	if err != nil {
+		return nil, fmt.Errorf("get active contest: %w", err)
+	}
+	return &contest, nil
+}
+
+func GetContest(db sqlx.Queryer, id int64) (*Contest, error) {
+	var contest Contest
+	err := sqlx.Get(db, &contest, "SELECT * FROM `contests` WHERE `id` = ? LIMIT 1", id)
+	if err != nil {
+		return nil, fmt.Errorf("get contest: %w", err)
+	}
+	return &contest, nil
+}
+
+func (c *Contest) Archived() bool {
+	return c.ArchivedAt.Valid
+}
+
+// CheckContestWritable は id のコンテストがアーカイブ済みなら ErrContestArchived を返す。
+func CheckContestWritable(db sqlx.Queryer, id int64) error {
+	contest, err := GetContest(db, id)
+	if err != nil {
+		return err
+	}
+	if contest.Archived() {
+		return ErrContestArchived
+	}
+	return nil
+}
+
+// StatusAt は now 時点での進行状況を計算する。
+func (c *Contest) StatusAt(now time.Time) *ContestStatus {
+	contestStatus := &ContestStatus{Contest: *c, CurrentTime: now}
+	switch {
+	case now.Before(c.RegistrationOpenAt):
+		contestStatus.Status = resources.Contest_STANDBY
+	case now.Before(c.ContestStartsAt):
+		contestStatus.Status = resources.Contest_REGISTRATION
+	case now.Before(c.ContestEndsAt):
+		contestStatus.Status = resources.Contest_STARTED
+	default:
+		contestStatus.Status = resources.Contest_FINISHED
+	}
+	contestStatus.Frozen = !now.Before(c.ContestStartsAt) && now.Before(c.ContestFreezesAt)
+	return contestStatus
+}
+
+// GetContestStatus はアクティブなコンテストの、clock の現在時刻での進行状況を返す。
+func GetContestStatus(db sqlx.Queryer, clock Clock) (*ContestStatus, error) {
+	contest, err := GetActiveContest(db)
+	if err != nil {
+		return nil, err
+	}
+	return contest.StatusAt(clock.Now()), nil
+}
@@ -0,0 +1,45 @@
+package xsuportal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+func TestContestStatusAt(t *testing.T) {
+	base := time.Date(2020, 10, 3, 10, 0, 0, 0, time.UTC)
+	contest := &Contest{
+		RegistrationOpenAt: base,
+		ContestStartsAt:    base.Add(time.Hour),
+		ContestFreezesAt:   base.Add(4 * time.Hour),
+		ContestEndsAt:      base.Add(5 * time.Hour),
+	}
+	tests := []struct {
+		name   string
+		now    time.Time
+		status resources.Contest_Status
+		frozen bool
+	}{
+		{"before registration", base.Add(-time.Second), resources.Contest_STANDBY, false},
+		{"registration opens", base, resources.Contest_REGISTRATION, false},
+		{"contest starts", base.Add(time.Hour), resources.Contest_STARTED, true},
+		{"just before freezes_at", base.Add(4*time.Hour - time.Second), resources.Contest_STARTED, true},
+		{"freezes_at", base.Add(4 * time.Hour), resources.Contest_STARTED, false},
+		{"contest ends", base.Add(5 * time.Hour), resources.Contest_FINISHED, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := contest.StatusAt(tt.now)
+			if status.Status != tt.status {
+				t.Errorf("Status = %v, want %v", status.Status, tt.status)
+			}
+			if status.Frozen != tt.frozen {
+				t.Errorf("Frozen = %v, want %v", status.Frozen, tt.frozen)
+			}
+			if !status.CurrentTime.Equal(tt.now) {
+				t.Errorf("CurrentTime = %v, want %v", status.CurrentTime, tt.now)
+			}
+		})
+	}
+}
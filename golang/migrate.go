@@ -0,0 +1,442 @@
+package xsuportal
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/isucon/isucon10-final/webapp/golang/util"
+)
+
+const (
+	MigrationLockName    = "xsuportal_migrations"
+	MigrationLockTimeout = 60 * time.Second
+	// BaselineMigrationVersion は migrate を導入する前の sql/schema.sql そのもので、中身は DROP TABLE と CREATE TABLE。
+	BaselineMigrationVersion = 1
+)
+
+// Migration は <version>_<name>.up.sql と <version>_<name>.down.sql の組。
+// Checksum は up の SQL の SHA-256 で、適用済みのマイグレーションを書き換えていないかの確認に使う。
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+type AppliedMigration struct {
+	Version   int64     `db:"version"`
+	Name      string    `db:"name"`
+	Checksum  string    `db:"checksum"`
+	AppliedAt time.Time `db:"applied_at"`
+}
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// MigrationsDir はマイグレーションを置いているディレクトリを返す。public と同じく golang ディレクトリで起動する前提。
+func MigrationsDir() string {
+	return util.GetEnv("XSUPORTAL_MIGRATIONS_DIR", "../sql/migrations")
+}
+
+// LoadMigrations は dir のマイグレーションを version の昇順で返す。
+func LoadMigrations(dir string) ([]Migration, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		m := migrationFileRegexp.FindStringSubmatch(file.Name())
+		if m == nil || file.IsDir() {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", file.Name())
+		}
+		body, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read migration: %w", err)
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has different names: %s, %s", version, migration.Name, m[2])
+		}
+		if m[3] == "up" {
+			migration.Up = string(body)
+			sum := sha256.Sum256(body)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(body)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up.sql", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// splitStatements は行末の ; で SQL を文に分ける。-- で始まる行は読み飛ばすので、文字列の中に行末の ; を書かないこと。
+func splitStatements(body string) []string {
+	var statements []string
+	var b strings.Builder
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(b.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// Migrator は schema_migrations に適用済みのマイグレーションを記録しながら DB を更新する。
+// xsuportal と benchmark_server が同時に起動しても二重に適用しないよう、操作中は GET_LOCK で MigrationLockName を取る。
+// MySQL の DDL はトランザクションに入らないので、途中で失敗したマイグレーションは途中まで反映されたまま記録されない。
+// 直してから up し直せるよう、1 つのマイグレーションには 1 つの変更だけを書くこと。
+type Migrator struct {
+	DB         *sqlx.DB
+	Migrations []Migration
+}
+
+// MigrationStatus は 1 件のマイグレーションの状態。
+// ファイルはないが適用済みのものは Migration が nil で、適用済みで checksum が違うものは Modified が立つ。
+type MigrationStatus struct {
+	Version   int64
+	Migration *Migration
+	Applied   *AppliedMigration
+	Modified  bool
+}
+
+func (s *MigrationStatus) String() string {
+	name := ""
+	if s.Migration != nil {
+		name = s.Migration.Name
+	} else if s.Applied != nil {
+		name = s.Applied.Name
+	}
+	var state string
+	switch {
+	case s.Applied == nil:
+		state = "pending"
+	case s.Migration == nil:
+		state = "missing (applied at " + s.Applied.AppliedAt.Format(time.RFC3339) + ")"
+	case s.Modified:
+		state = "modified (applied at " + s.Applied.AppliedAt.Format(time.RFC3339) + ")"
+	default:
+		state = "applied at " + s.Applied.AppliedAt.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%04d_%s\t%s", s.Version, name, state)
+}
+
+func (m *Migrator) withLock(f func(conn *sqlx.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.DB.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("get conn: %w", err)
+	}
+	defer conn.Close()
+	var locked sql.NullBool
+	err = conn.GetContext(ctx, &locked, "SELECT GET_LOCK(?, ?)", MigrationLockName, int(MigrationLockTimeout.Seconds()))
+	if err != nil {
+		return fmt.Errorf("get migration lock: %w", err)
+	}
+	if !locked.Bool {
+		return fmt.Errorf("get migration lock: timed out after %v", MigrationLockTimeout)
+	}
+	defer conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", MigrationLockName)
+	_, err = conn.ExecContext(
+		ctx,
+		"CREATE TABLE IF NOT EXISTS `schema_migrations` (`version` BIGINT NOT NULL PRIMARY KEY, `name` VARCHAR(255) NOT NULL, `checksum` CHAR(64) NOT NULL, `applied_at` DATETIME(6) NOT NULL) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4",
+	)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return f(conn)
+}
+
+func (m *Migrator) status(conn *sqlx.Conn) ([]MigrationStatus, error) {
+	var applied []AppliedMigration
+	err := conn.SelectContext(context.Background(), &applied, "SELECT * FROM `schema_migrations` ORDER BY `version`")
+	if err != nil {
+		return nil, fmt.Errorf("select schema_migrations: %w", err)
+	}
+	return migrationStatuses(m.Migrations, applied), nil
+}
+
+// migrationStatuses はファイルのマイグレーションと schema_migrations の行を version ごとに突き合わせる。
+func migrationStatuses(migrations []Migration, applied []AppliedMigration) []MigrationStatus {
+	byVersion := make(map[int64]*MigrationStatus)
+	for i := range migrations {
+		byVersion[migrations[i].Version] = &MigrationStatus{Version: migrations[i].Version, Migration: &migrations[i]}
+	}
+	for i := range applied {
+		status, ok := byVersion[applied[i].Version]
+		if !ok {
+			status = &MigrationStatus{Version: applied[i].Version}
+			byVersion[applied[i].Version] = status
+		}
+		status.Applied = &applied[i]
+		status.Modified = status.Migration != nil && status.Migration.Checksum != applied[i].Checksum
+	}
+	statuses := make([]MigrationStatus, 0, len(byVersion))
+	for _, status := range byVersion {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses
+}
+
+// Status は全てのマイグレーションの状態を version の昇順で返す。
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.withLock(func(conn *sqlx.Conn) error {
+		var err error
+		statuses, err = m.status(conn)
+		return err
+	})
+	return statuses, err
+}
+
+// Up は target 以下の未適用のマイグレーションを古い順に適用し、適用したものを返す。target が 0 なら全て適用する。
+// 適用済みのマイグレーションが書き換えられていたりファイルがなくなっていたりすれば、何もせずにエラーを返す。
+// schema_migrations が空なのに contestants があれば sql/schema.sql で作った既存の DB なので、ベースラインは実行せずに適用済みとして記録する。
+func (m *Migrator) Up(target int64) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(func(conn *sqlx.Conn) error {
+		statuses, err := m.status(conn)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if status.Applied == nil {
+				continue
+			}
+			if status.Migration == nil {
+				return fmt.Errorf("migration %d is applied but not found", status.Version)
+			}
+			if status.Modified {
+				return fmt.Errorf("migration %d_%s has been modified after applied", status.Version, status.Migration.Name)
+			}
+		}
+		adopt, err := m.existingSchema(conn, statuses)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			if status.Applied != nil || (target != 0 && status.Version > target) {
+				continue
+			}
+			if adopt && status.Version == BaselineMigrationVersion {
+				log.Printf("[INFO] adopted existing schema as migration %04d_%s", status.Version, status.Migration.Name)
+			} else if err := m.exec(conn, status.Migration, status.Migration.Up); err != nil {
+				return err
+			}
+			_, err := conn.ExecContext(
+				context.Background(),
+				"INSERT INTO `schema_migrations` (`version`, `name`, `checksum`, `applied_at`) VALUES (?, ?, ?, NOW(6))",
+				status.Version,
+				status.Migration.Name,
+				status.Migration.Checksum,
+			)
+			if err != nil {
+				return fmt.Errorf("insert schema_migrations: %w", err)
+			}
+			done = append(done, *status.Migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+func (m *Migrator) existingSchema(conn *sqlx.Conn, statuses []MigrationStatus) (bool, error) {
+	for _, status := range statuses {
+		if status.Applied != nil {
+			return false, nil
+		}
+	}
+	var exists bool
+	err := conn.GetContext(
+		context.Background(),
+		&exists,
+		"SELECT 1 FROM `information_schema`.`tables` WHERE `table_schema` = DATABASE() AND `table_name` = 'contestants' LIMIT 1",
+	)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get existing tables: %w", err)
+	}
+	return true, nil
+}
+
+// Down は target より新しい適用済みのマイグレーションを新しい順に戻し、戻したものを返す。target が 0 なら全て戻す。
+func (m *Migrator) Down(target int64) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(func(conn *sqlx.Conn) error {
+		statuses, err := m.status(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(statuses) - 1; i >= 0; i-- {
+			status := statuses[i]
+			if status.Applied == nil || status.Version <= target {
+				continue
+			}
+			if status.Migration == nil {
+				return fmt.Errorf("migration %d is applied but not found", status.Version)
+			}
+			if status.Migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down.sql", status.Version, status.Migration.Name)
+			}
+			if err := m.exec(conn, status.Migration, status.Migration.Down); err != nil {
+				return err
+			}
+			_, err := conn.ExecContext(context.Background(), "DELETE FROM `schema_migrations` WHERE `version` = ? LIMIT 1", status.Version)
+			if err != nil {
+				return fmt.Errorf("delete schema_migrations: %w", err)
+			}
+			done = append(done, *status.Migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+func (m *Migrator) exec(conn *sqlx.Conn, migration *Migration, body string) error {
+	for _, statement := range splitStatements(body) {
+		if _, err := conn.ExecContext(context.Background(), statement); err != nil {
+			return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return nil
+}
+
+// RunMigrateCommand は migrate サブコマンドを実行する。args は migrate より後ろの引数。
+//
+//	migrate up [version]    version まで (省略すると最新まで) 適用する
+//	migrate down [version]  version まで戻す。省略すると最後の 1 件だけ戻し、0 なら全て戻す
+//	migrate status          状態を表示する
+func RunMigrateCommand(db *sqlx.DB, args []string, out io.Writer) error {
+	migrations, err := LoadMigrations(MigrationsDir())
+	if err != nil {
+		return err
+	}
+	migrator := &Migrator{DB: db, Migrations: migrations}
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down|status [version]")
+	}
+	var target int64
+	hasTarget := len(args) > 1
+	if hasTarget {
+		target, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil || target < 0 {
+			return fmt.Errorf("invalid version: %q", args[1])
+		}
+	}
+	switch args[0] {
+	case "up":
+		done, err := migrator.Up(target)
+		for _, migration := range done {
+			fmt.Fprintf(out, "applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "down":
+		if !hasTarget {
+			// 最後に適用したものの 1 つ前まで戻す
+			statuses, err := migrator.Status()
+			if err != nil {
+				return err
+			}
+			target = -1
+			for i := len(statuses) - 1; i >= 0; i-- {
+				if statuses[i].Applied == nil {
+					continue
+				}
+				if target < 0 {
+					target = 0
+					continue
+				}
+				target = statuses[i].Version
+				break
+			}
+			if target < 0 {
+				return nil
+			}
+		}
+		done, err := migrator.Down(target)
+		for _, migration := range done {
+			fmt.Fprintf(out, "reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for i := range statuses {
+			fmt.Fprintln(out, statuses[i].String())
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command: %q", args[0])
+	}
+}
+
+// MigrateOnStart はサーバーの起動時に呼ぶ。XSUPORTAL_AUTO_MIGRATE が true なら未適用のマイグレーションを適用し、
+// そうでなければ未適用のものがあるときに警告だけ出す。
+func MigrateOnStart(db *sqlx.DB) error {
+	migrations, err := LoadMigrations(MigrationsDir())
+	if err != nil {
+		return err
+	}
+	migrator := &Migrator{DB: db, Migrations: migrations}
+	if auto, _ := strconv.ParseBool(util.GetEnv("XSUPORTAL_AUTO_MIGRATE", "false")); auto {
+		done, err := migrator.Up(0)
+		for _, migration := range done {
+			log.Printf("[INFO] applied migration %04d_%s", migration.Version, migration.Name)
+		}
+		return err
+	}
+	statuses, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	for i := range statuses {
+		if statuses[i].Applied == nil || statuses[i].Modified {
+			log.Printf("[WARN] migration %s", statuses[i].String())
+		}
+	}
+	return nil
+}
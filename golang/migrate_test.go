@@ -0,0 +1,114 @@
+package xsuportal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeMigrations(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	for name, body := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func checksum(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestLoadMigrations(t *testing.T) {
+	dir := writeMigrations(t, map[string]string{
+		"0002_add_column.up.sql":   "ALTER TABLE `a` ADD COLUMN `b` INT;\n",
+		"0002_add_column.down.sql": "ALTER TABLE `a` DROP COLUMN `b`;\n",
+		"0001_baseline.up.sql":     "CREATE TABLE `a` (`id` INT);\n",
+		"0010_no_down.up.sql":      "SELECT 1;\n",
+		"README.md":                "not a migration",
+	})
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Migration{
+		{Version: 1, Name: "baseline", Up: "CREATE TABLE `a` (`id` INT);\n", Checksum: checksum("CREATE TABLE `a` (`id` INT);\n")},
+		{Version: 2, Name: "add_column", Up: "ALTER TABLE `a` ADD COLUMN `b` INT;\n", Down: "ALTER TABLE `a` DROP COLUMN `b`;\n", Checksum: checksum("ALTER TABLE `a` ADD COLUMN `b` INT;\n")},
+		{Version: 10, Name: "no_down", Up: "SELECT 1;\n", Checksum: checksum("SELECT 1;\n")},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("len(migrations) = %d, want %d", len(migrations), len(want))
+	}
+	for i := range want {
+		if migrations[i] != want[i] {
+			t.Errorf("migrations[%d] = %+v, want %+v", i, migrations[i], want[i])
+		}
+	}
+}
+
+func TestLoadMigrationsError(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{"no up", map[string]string{"0001_a.down.sql": "SELECT 1;"}},
+		{"different names", map[string]string{"0001_a.up.sql": "SELECT 1;", "0001_b.down.sql": "SELECT 1;"}},
+		{"zero version", map[string]string{"0000_a.up.sql": "SELECT 1;"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadMigrations(writeMigrations(t, tt.files)); err == nil {
+				t.Error("LoadMigrations() returned no error")
+			}
+		})
+	}
+}
+
+func TestMigrationStatusesChecksumMismatch(t *testing.T) {
+	dir := writeMigrations(t, map[string]string{
+		"0001_baseline.up.sql": "CREATE TABLE `a` (`id` INT);\n",
+		"0002_modified.up.sql": "ALTER TABLE `a` ADD COLUMN `c` INT;\n",
+		"0003_pending.up.sql":  "ALTER TABLE `a` ADD COLUMN `d` INT;\n",
+	})
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied := []AppliedMigration{
+		{Version: 1, Name: "baseline", Checksum: checksum("CREATE TABLE `a` (`id` INT);\n")},
+		{Version: 2, Name: "modified", Checksum: checksum("ALTER TABLE `a` ADD COLUMN `b` INT;\n")},
+		{Version: 4, Name: "missing", Checksum: checksum("SELECT 1;\n")},
+	}
+	statuses := migrationStatuses(migrations, applied)
+	tests := []struct {
+		version  int64
+		applied  bool
+		missing  bool
+		modified bool
+	}{
+		{version: 1, applied: true},
+		{version: 2, applied: true, modified: true},
+		{version: 3},
+		{version: 4, applied: true, missing: true},
+	}
+	if len(statuses) != len(tests) {
+		t.Fatalf("len(statuses) = %d, want %d", len(statuses), len(tests))
+	}
+	for i, tt := range tests {
+		s := statuses[i]
+		if s.Version != tt.version || (s.Applied != nil) != tt.applied || (s.Migration == nil) != tt.missing || s.Modified != tt.modified {
+			t.Errorf("statuses[%d] = %s (modified: %v), want version %d applied: %v missing: %v modified: %v",
+				i, s.String(), s.Modified, tt.version, tt.applied, tt.missing, tt.modified)
+		}
+	}
+}
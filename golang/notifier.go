@@ -1,13 +1,18 @@
 package xsuportal
 
 import (
+	"context"
 	"crypto/elliptic"
 	"crypto/x509"
+	"database/sql"
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/SherClockHolmes/webpush-go"
 	"github.com/golang/protobuf/proto"
@@ -20,11 +25,27 @@ import (
 const (
 	WebpushVAPIDPrivateKeyPath = "../vapid_private.pem"
 	WebpushSubject             = "xsuportal@example.com"
+	WebpushTimeout             = 5 * time.Second
 )
 
+var ErrPushSubscriptionGone = errors.New("push subscription is gone")
+
 type Notifier struct {
+	// Clock は通知の作成時刻に使う。nil なら SystemClock を使う。
+	Clock Clock
+
 	mu      sync.Mutex
 	options *webpush.Options
+
+	once   sync.Once
+	outbox Outbox
+}
+
+func (n *Notifier) clock() Clock {
+	if n.Clock == nil {
+		return SystemClock{}
+	}
+	return n.Clock
 }
 
 func (n *Notifier) VAPIDKey() *webpush.Options {
@@ -51,120 +72,355 @@ func (n *Notifier) VAPIDKey() *webpush.Options {
 			Subscriber:      WebpushSubject,
 			VAPIDPrivateKey: pri,
 			VAPIDPublicKey:  pub,
+			HTTPClient:      &http.Client{Timeout: WebpushTimeout},
 		}
 	}
 	return n.options
 }
 
-func (n *Notifier) NotifyClarificationAnswered(db sqlx.Ext, c *Clarification, updated bool) error {
-	var contestants []struct {
-		ID     string `db:"id"`
-		TeamID int64  `db:"team_id"`
+const (
+	OutboxKindClarificationAnswered = "clarification_answered"
+	OutboxKindBenchmarkJobFinished  = "benchmark_job_finished"
+	OutboxKindWebPush               = "web_push"
+)
+
+type clarificationAnsweredEvent struct {
+	ClarificationID int64 `json:"clarification_id"`
+	TeamID          int64 `json:"team_id"`
+	Disclosed       bool  `json:"disclosed"`
+	Updated         bool  `json:"updated"`
+}
+
+type benchmarkJobFinishedEvent struct {
+	BenchmarkJobID int64 `json:"benchmark_job_id"`
+	TeamID         int64 `json:"team_id"`
+	Requeued       bool  `json:"requeued,omitempty"`
+}
+
+// webPushEvent は NotificationID の通知を送る。アプリ内通知を作らなかった場合は EncodedMessage をそのまま送る。
+type webPushEvent struct {
+	NotificationID     int64  `json:"notification_id,omitempty"`
+	EncodedMessage     string `json:"encoded_message,omitempty"`
+	PushSubscriptionID int64  `json:"push_subscription_id"`
+}
+
+func (n *Notifier) Outbox() *Outbox {
+	n.once.Do(func() {
+		n.outbox.Handle(OutboxKindClarificationAnswered, n.handleClarificationAnswered)
+		n.outbox.Handle(OutboxKindBenchmarkJobFinished, n.handleBenchmarkJobFinished)
+		n.outbox.HandleFunc(OutboxKindWebPush, n.handleWebPush)
+	})
+	return &n.outbox
+}
+
+// Run は outbox に積まれた通知を ctx がキャンセルされるまで配信し続ける。
+func (n *Notifier) Run(ctx context.Context, db *sqlx.DB, workers int) {
+	n.Outbox().Run(ctx, db, workers)
+}
+
+// Updated は次にこのプロセスで通知が作られたときに close されるチャネルを返す。
+func (n *Notifier) Updated() <-chan struct{} {
+	return n.Outbox().Processed()
+}
+
+// NotifyClarificationAnswered はイベントを outbox に積むだけで、通知の作成と Web Push は Run で行われる。
+// db に更新中のトランザクションを渡せば、コミットされた場合にだけ通知される。
+// 各コンテスタントの NotificationPreference は配信時に参照されるので、積んだ後の設定変更も反映される。
+func (n *Notifier) NotifyClarificationAnswered(db sqlx.Execer, c *Clarification, updated bool) error {
+	return n.Outbox().Enqueue(db, OutboxKindClarificationAnswered, &clarificationAnsweredEvent{
+		ClarificationID: c.ID,
+		TeamID:          c.TeamID,
+		Disclosed:       c.Disclosed.Valid && c.Disclosed.Bool,
+		Updated:         updated,
+	})
+}
+
+func (n *Notifier) NotifyBenchmarkJobFinished(db sqlx.Execer, job *BenchmarkJob) error {
+	return n.Outbox().Enqueue(db, OutboxKindBenchmarkJobFinished, &benchmarkJobFinishedEvent{
+		BenchmarkJobID: job.ID,
+		TeamID:         job.TeamID,
+	})
+}
+
+// NotifyBenchmarkJobRequeued は応答のないジョブを再実行に戻したことを、終了とは区別してチームに通知する。
+func (n *Notifier) NotifyBenchmarkJobRequeued(db sqlx.Execer, job *BenchmarkJob) error {
+	return n.Outbox().Enqueue(db, OutboxKindBenchmarkJobFinished, &benchmarkJobFinishedEvent{
+		BenchmarkJobID: job.ID,
+		TeamID:         job.TeamID,
+		Requeued:       true,
+	})
+}
+
+type notificationRecipient struct {
+	ID     string `db:"id"`
+	TeamID int64  `db:"team_id"`
+}
+
+func (n *Notifier) handleClarificationAnswered(tx *sqlx.Tx, event *OutboxEvent) error {
+	var ev clarificationAnsweredEvent
+	if err := event.Decode(&ev); err != nil {
+		return err
+	}
+	var contestID int64
+	err := tx.Get(&contestID, "SELECT `contest_id` FROM `clarifications` WHERE `id` = ? LIMIT 1", ev.ClarificationID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get clarification contest: %w", err)
 	}
-	if c.Disclosed.Valid && c.Disclosed.Bool {
-		err := sqlx.Select(
-			db,
+	var contestants []notificationRecipient
+	if ev.Disclosed {
+		err := tx.Select(
 			&contestants,
-			"SELECT `id`, `team_id` FROM `contestants` WHERE `team_id` IS NOT NULL",
+			"SELECT `contestants`.`id`, `contestants`.`team_id` FROM `contestants` INNER JOIN `teams` ON `teams`.`id` = `contestants`.`team_id` WHERE `teams`.`contest_id` = ?",
+			contestID,
 		)
 		if err != nil {
-			return fmt.Errorf("select all contestants: %w", err)
+			return fmt.Errorf("select contestants(contest_id=%v): %w", contestID, err)
 		}
 	} else {
-		err := sqlx.Select(
-			db,
+		err := tx.Select(
 			&contestants,
 			"SELECT `id`, `team_id` FROM `contestants` WHERE `team_id` = ?",
-			c.TeamID,
+			ev.TeamID,
 		)
 		if err != nil {
-			return fmt.Errorf("select contestants(team_id=%v): %w", c.TeamID, err)
+			return fmt.Errorf("select contestants(team_id=%v): %w", ev.TeamID, err)
 		}
 	}
-	for _, contestant := range contestants {
-		notificationPB := &resources.Notification{
+	return n.deliver(tx, contestID, contestants, func(contestant notificationRecipient) (string, *resources.Notification) {
+		owned := ev.TeamID == contestant.TeamID
+		contentType := NotificationContentDisclosedClarification
+		if owned {
+			contentType = NotificationContentOwnClarification
+		}
+		return contentType, &resources.Notification{
 			Content: &resources.Notification_ContentClarification{
 				ContentClarification: &resources.Notification_ClarificationMessage{
-					ClarificationId: c.ID,
-					Owned:           c.TeamID == contestant.TeamID,
-					Updated:         updated,
+					ClarificationId: ev.ClarificationID,
+					Owned:           owned,
+					Updated:         ev.Updated,
 				},
 			},
 		}
-		notification, err := n.notify(db, notificationPB, contestant.ID)
-		if err != nil {
-			return fmt.Errorf("notify: %w", err)
-		}
-		if n.VAPIDKey() != nil {
-			notificationPB.Id = notification.ID
-			notificationPB.CreatedAt = timestamppb.New(notification.CreatedAt)
-			// TODO: Web Push IIKANJI NI SHITE
-		}
-	}
-	return nil
+	})
 }
 
-func (n *Notifier) NotifyBenchmarkJobFinished(db sqlx.Ext, job *BenchmarkJob) error {
-	var contestants []struct {
-		ID     string `db:"id"`
-		TeamID int64  `db:"team_id"`
+func (n *Notifier) handleBenchmarkJobFinished(tx *sqlx.Tx, event *OutboxEvent) error {
+	var ev benchmarkJobFinishedEvent
+	if err := event.Decode(&ev); err != nil {
+		return err
+	}
+	var contestID int64
+	err := tx.Get(&contestID, "SELECT `contest_id` FROM `benchmark_jobs` WHERE `id` = ? LIMIT 1", ev.BenchmarkJobID)
+	if err == sql.ErrNoRows {
+		return nil
 	}
-	err := sqlx.Select(
-		db,
+	if err != nil {
+		return fmt.Errorf("get benchmark job contest: %w", err)
+	}
+	var contestants []notificationRecipient
+	err = tx.Select(
 		&contestants,
 		"SELECT `id`, `team_id` FROM `contestants` WHERE `team_id` = ?",
-		job.TeamID,
+		ev.TeamID,
 	)
 	if err != nil {
-		return fmt.Errorf("select contestants(team_id=%v): %w", job.TeamID, err)
+		return fmt.Errorf("select contestants(team_id=%v): %w", ev.TeamID, err)
 	}
-	for _, contestant := range contestants {
-		notificationPB := &resources.Notification{
+	return n.deliver(tx, contestID, contestants, func(contestant notificationRecipient) (string, *resources.Notification) {
+		return NotificationContentBenchmarkJob, &resources.Notification{
 			Content: &resources.Notification_ContentBenchmarkJob{
 				ContentBenchmarkJob: &resources.Notification_BenchmarkJobMessage{
-					BenchmarkJobId: job.ID,
+					BenchmarkJobId: ev.BenchmarkJobID,
+					Requeued:       ev.Requeued,
 				},
 			},
 		}
-		notification, err := n.notify(db, notificationPB, contestant.ID)
+	})
+}
+
+// deliver は各コンテスタントの設定に従って、contestID のコンテストのアプリ内通知を作り Web Push を積む。
+func (n *Notifier) deliver(tx *sqlx.Tx, contestID int64, contestants []notificationRecipient, build func(contestant notificationRecipient) (string, *resources.Notification)) error {
+	contestantIDs := make([]string, 0, len(contestants))
+	for _, contestant := range contestants {
+		contestantIDs = append(contestantIDs, contestant.ID)
+	}
+	prefs, err := GetNotificationPreferences(tx, contestantIDs)
+	if err != nil {
+		return err
+	}
+	pushes := make(map[string]*webPushEvent, len(contestants))
+	for _, contestant := range contestants {
+		contentType, notificationPB := build(contestant)
+		pref := prefs.Get(contestant.ID, contentType)
+		if !pref.InApp && !pref.WebPush {
+			continue
+		}
+		m, err := proto.Marshal(notificationPB)
 		if err != nil {
-			return fmt.Errorf("notify: %w", err)
+			return fmt.Errorf("marshal notification: %w", err)
+		}
+		encodedMessage := base64.StdEncoding.EncodeToString(m)
+		push := &webPushEvent{}
+		if pref.InApp {
+			id, err := n.notify(tx, contestID, encodedMessage, contestant.ID)
+			if err != nil {
+				return fmt.Errorf("notify: %w", err)
+			}
+			push.NotificationID = id
+		} else {
+			push.EncodedMessage = encodedMessage
 		}
-		if n.VAPIDKey() != nil {
-			notificationPB.Id = notification.ID
-			notificationPB.CreatedAt = timestamppb.New(notification.CreatedAt)
-			// TODO: Web Push IIKANJI NI SHITE
+		if pref.WebPush {
+			pushes[contestant.ID] = push
 		}
 	}
-	return nil
+	return n.enqueueWebPushes(tx, pushes)
 }
 
-func (n *Notifier) notify(db sqlx.Ext, notificationPB *resources.Notification, contestantID string) (*Notification, error) {
-	m, err := proto.Marshal(notificationPB)
-	if err != nil {
-		return nil, fmt.Errorf("marshal notification: %w", err)
-	}
-	encodedMessage := base64.StdEncoding.EncodeToString(m)
+func (n *Notifier) notify(db sqlx.Execer, contestID int64, encodedMessage string, contestantID string) (int64, error) {
 	res, err := db.Exec(
-		"INSERT INTO `notifications` (`contestant_id`, `encoded_message`, `read`, `created_at`, `updated_at`) VALUES (?, ?, FALSE, NOW(6), NOW(6))",
+		"INSERT INTO `notifications` (`contest_id`, `contestant_id`, `encoded_message`, `read`, `created_at`, `updated_at`) VALUES (?, ?, ?, FALSE, ?, NOW(6))",
+		contestID,
 		contestantID,
 		encodedMessage,
+		n.clock().Now(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("insert notification: %w", err)
-	}
-	lastInsertID, _ := res.LastInsertId()
-	var notification Notification
-	err = sqlx.Get(
-		db,
-		&notification,
-		"SELECT * FROM `notifications` WHERE `id` = ? LIMIT 1",
-		lastInsertID,
+		return 0, fmt.Errorf("insert notification: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("get inserted notification id: %w", err)
+	}
+	return id, nil
+}
+
+// enqueueWebPushes は購読ごとに web_push イベントを積む。失敗した購読だけが個別にリトライされる。
+func (n *Notifier) enqueueWebPushes(tx *sqlx.Tx, pushes map[string]*webPushEvent) error {
+	if n.VAPIDKey() == nil || len(pushes) == 0 {
+		return nil
+	}
+	contestantIDs := make([]string, 0, len(pushes))
+	for contestantID := range pushes {
+		contestantIDs = append(contestantIDs, contestantID)
+	}
+	query, params, err := sqlx.In(
+		"SELECT * FROM `push_subscriptions` WHERE `contestant_id` IN (?)",
+		contestantIDs,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("get inserted notification: %w", err)
+		return fmt.Errorf("build query: %w", err)
+	}
+	var subscriptions []PushSubscription
+	if err := tx.Select(&subscriptions, query, params...); err != nil {
+		return fmt.Errorf("select push subscriptions: %w", err)
+	}
+	for _, subscription := range subscriptions {
+		push := *pushes[subscription.ContestantID]
+		push.PushSubscriptionID = subscription.ID
+		if err := n.Outbox().Enqueue(tx, OutboxKindWebPush, &push); err != nil {
+			return fmt.Errorf("enqueue web push: %w", err)
+		}
 	}
-	return &notification, nil
+	return nil
 }
 
+// handleWebPush は push サービスへの HTTP リクエストを待つので、トランザクションを張らずに処理する。
+func (n *Notifier) handleWebPush(db *sqlx.DB, event *OutboxEvent) error {
+	var ev webPushEvent
+	if err := event.Decode(&ev); err != nil {
+		return err
+	}
+	options := n.VAPIDKey()
+	if options == nil {
+		return nil
+	}
+	var subscription PushSubscription
+	err := db.Get(
+		&subscription,
+		"SELECT * FROM `push_subscriptions` WHERE `id` = ? LIMIT 1",
+		ev.PushSubscriptionID,
+	)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get push subscription: %w", err)
+	}
+	notification := Notification{
+		EncodedMessage: ev.EncodedMessage,
+		CreatedAt:      n.clock().At(event.CreatedAt),
+	}
+	if ev.NotificationID != 0 {
+		err = db.Get(
+			&notification,
+			"SELECT * FROM `notifications` WHERE `id` = ? LIMIT 1",
+			ev.NotificationID,
+		)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get notification: %w", err)
+		}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(notification.EncodedMessage)
+	if err != nil {
+		return fmt.Errorf("decode message: %w", err)
+	}
+	var notificationPB resources.Notification
+	if err := proto.Unmarshal(decoded, &notificationPB); err != nil {
+		return fmt.Errorf("unmarshal message: %w", err)
+	}
+	notificationPB.Id = notification.ID
+	notificationPB.CreatedAt = timestamppb.New(notification.CreatedAt)
+	m, err := proto.Marshal(&notificationPB)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+	message := make([]byte, base64.StdEncoding.EncodedLen(len(m)))
+	base64.StdEncoding.Encode(message, m)
+
+	err = SendWebPush(options, message, &subscription)
+	if errors.Is(err, ErrPushSubscriptionGone) {
+		_, err = db.Exec(
+			"DELETE FROM `push_subscriptions` WHERE `id` = ? LIMIT 1",
+			subscription.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("delete push subscription(id=%v): %w", subscription.ID, err)
+		}
+		return nil
+	}
+	return err
+}
 
+// SendWebPush は message をそのまま subscription に送る。
+// エンドポイントが 404/410 を返した場合は ErrPushSubscriptionGone を返すので、呼び出し元で購読を削除すること。
+func SendWebPush(options *webpush.Options, message []byte, subscription *PushSubscription) error {
+	resp, err := webpush.SendNotification(
+		message,
+		&webpush.Subscription{
+			Endpoint: subscription.Endpoint,
+			Keys: webpush.Keys{
+				Auth:   subscription.Auth,
+				P256dh: subscription.P256DH,
+			},
+		},
+		options,
+	)
+	if err != nil {
+		return fmt.Errorf("send notification: %w", err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound, resp.StatusCode == http.StatusGone:
+		return ErrPushSubscriptionGone
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	return nil
+}
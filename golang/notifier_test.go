@@ -0,0 +1,273 @@
+package xsuportal
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/SherClockHolmes/webpush-go"
+	"github.com/golang/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+)
+
+func TestSendWebPush(t *testing.T) {
+	vapidPrivateKey, vapidPublicKey, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := &webpush.Options{
+		Subscriber:      WebpushSubject,
+		VAPIDPrivateKey: vapidPrivateKey,
+		VAPIDPublicKey:  vapidPublicKey,
+	}
+	// ブラウザ側の購読鍵
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		err    error
+		ok     bool
+	}{
+		{name: "created", status: http.StatusCreated, ok: true},
+		{name: "not found", status: http.StatusNotFound, err: ErrPushSubscriptionGone},
+		{name: "gone", status: http.StatusGone, err: ErrPushSubscriptionGone},
+		{name: "server error", status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			subscription := &PushSubscription{
+				Endpoint: srv.URL + "/push/1",
+				P256DH:   base64.RawURLEncoding.EncodeToString(elliptic.Marshal(elliptic.P256(), key.X, key.Y)),
+				Auth:     base64.RawURLEncoding.EncodeToString(auth),
+			}
+			err := SendWebPush(options, []byte("message"), subscription)
+			switch {
+			case tt.ok && err != nil:
+				t.Fatalf("SendWebPush() = %v, want nil", err)
+			case !tt.ok && err == nil:
+				t.Fatal("SendWebPush() returned no error")
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Fatalf("SendWebPush() = %v, want %v", err, tt.err)
+			case tt.err == nil && errors.Is(err, ErrPushSubscriptionGone):
+				t.Fatalf("SendWebPush() = %v, want other error", err)
+			}
+
+			if received == nil {
+				t.Fatal("push endpoint was not called")
+			}
+			if received.Method != http.MethodPost || received.URL.Path != "/push/1" {
+				t.Errorf("request = %s %s, want POST /push/1", received.Method, received.URL.Path)
+			}
+			if got := received.Header.Get("Content-Encoding"); got != "aes128gcm" {
+				t.Errorf("Content-Encoding = %q, want aes128gcm", got)
+			}
+			if got := received.Header.Get("Authorization"); !strings.HasPrefix(got, "vapid t=") || !strings.Contains(got, "k="+vapidPublicKey) {
+				t.Errorf("Authorization = %q, want vapid header", got)
+			}
+			if len(body) == 0 || strings.Contains(string(body), "message") {
+				t.Errorf("body is not encrypted: %q", body)
+			}
+		})
+	}
+}
+
+// captureArg は一致させた引数の値を v に取っておく。
+type captureArg struct{ v *string }
+
+func (c captureArg) Match(v driver.Value) bool {
+	s, ok := v.([]byte)
+	if ok {
+		*c.v = string(s)
+		return true
+	}
+	*c.v, ok = v.(string)
+	return ok
+}
+
+func TestNotifierDeliversWebPushThroughOutbox(t *testing.T) {
+	vapidPrivateKey, vapidPublicKey, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+	// handle は送信の完了を待って返るので、受け取った数はロックなしで数えられる
+	received := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received[r.URL.Path]++
+		switch r.URL.Path {
+		case "/push/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/push/not_found":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer srv.Close()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlDB.Close()
+	db := sqlx.NewDb(sqlDB, "mysql")
+
+	n := &Notifier{
+		options: &webpush.Options{
+			Subscriber:      WebpushSubject,
+			VAPIDPrivateKey: vapidPrivateKey,
+			VAPIDPublicKey:  vapidPublicKey,
+		},
+	}
+	now := time.Now()
+	subscriptionColumns := []string{"id", "contestant_id", "endpoint", "p256dh", "auth", "created_at", "updated_at"}
+	subscriptions := []struct {
+		id   int64
+		path string
+		gone bool
+	}{
+		{id: 21, path: "/push/ok"},
+		{id: 22, path: "/push/gone", gone: true},
+		{id: 23, path: "/push/not_found", gone: true},
+	}
+	subscriptionRow := func(rows *sqlmock.Rows, id int64, path string) *sqlmock.Rows {
+		return rows.AddRow(
+			id,
+			"alice",
+			srv.URL+path,
+			base64.RawURLEncoding.EncodeToString(elliptic.Marshal(elliptic.P256(), key.X, key.Y)),
+			base64.RawURLEncoding.EncodeToString(auth),
+			now,
+			now,
+		)
+	}
+
+	// ジョブの終了イベントでアプリ内通知を作り、チームの購読ごとに web_push を積む
+	var encodedMessage string
+	payloads := make([]string, len(subscriptions))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `contest_id` FROM `benchmark_jobs` WHERE `id` = ?")).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"contest_id"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `team_id` FROM `contestants` WHERE `team_id` = ?")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "team_id"}).AddRow("alice", 3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `notification_preferences` WHERE `contestant_id` IN (?)")).
+		WithArgs("alice").
+		WillReturnRows(sqlmock.NewRows([]string{"contestant_id", "content_type", "in_app", "web_push"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `notifications`")).
+		WithArgs(2, "alice", captureArg{&encodedMessage}, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(100, 1))
+	rows := sqlmock.NewRows(subscriptionColumns)
+	for _, s := range subscriptions {
+		rows = subscriptionRow(rows, s.id, s.path)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `push_subscriptions` WHERE `contestant_id` IN (?)")).
+		WithArgs("alice").
+		WillReturnRows(rows)
+	for i := range subscriptions {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `notification_outbox`")).
+			WithArgs(OutboxKindWebPush, captureArg{&payloads[i]}).
+			WillReturnResult(sqlmock.NewResult(int64(2+i), 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `notification_outbox` SET `attempts` = `attempts` + 1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = n.Outbox().handle(db, &OutboxEvent{
+		ID:      1,
+		Kind:    OutboxKindBenchmarkJobFinished,
+		Payload: `{"benchmark_job_id":10,"team_id":3}`,
+	})
+	if err != nil {
+		t.Fatalf("handle benchmark_job_finished: %v", err)
+	}
+
+	// web_push は購読を引き直して送り、404/410 が返った購読は削除する
+	for i, s := range subscriptions {
+		eventID := int64(2 + i)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `push_subscriptions` WHERE `id` = ?")).
+			WithArgs(s.id).
+			WillReturnRows(subscriptionRow(sqlmock.NewRows(subscriptionColumns), s.id, s.path))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `notifications` WHERE `id` = ?")).
+			WithArgs(100).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "contest_id", "contestant_id", "read", "encoded_message", "created_at", "updated_at"}).
+				AddRow(100, 2, "alice", false, encodedMessage, now, now))
+		if s.gone {
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `push_subscriptions` WHERE `id` = ?")).
+				WithArgs(s.id).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE `notification_outbox` SET `attempts` = `attempts` + 1")).
+			WithArgs(eventID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := n.Outbox().handle(db, &OutboxEvent{
+			ID:        eventID,
+			Kind:      OutboxKindWebPush,
+			Payload:   payloads[i],
+			CreatedAt: now,
+		})
+		if err != nil {
+			t.Fatalf("handle web_push(subscription=%v): %v", s.id, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	var notificationPB resources.Notification
+	decoded, err := base64.StdEncoding.DecodeString(encodedMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(decoded, &notificationPB); err != nil {
+		t.Fatal(err)
+	}
+	if got := notificationPB.GetContentBenchmarkJob().GetBenchmarkJobId(); got != 10 {
+		t.Errorf("benchmark_job_id = %v, want 10", got)
+	}
+	for _, s := range subscriptions {
+		if received[s.path] != 1 {
+			t.Errorf("%s received %d pushes, want 1", s.path, received[s.path])
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package xsuportal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	OutboxBatchSize    = 64
+	OutboxPollInterval = 500 * time.Millisecond
+	OutboxLease        = 30 * time.Second
+	OutboxMaxAttempts  = 8
+	OutboxMaxBackoff   = 5 * time.Minute
+)
+
+// OutboxHandler はイベントを処理する。tx は handler の終了後に処理済みとしてマークされてからコミットされる。
+type OutboxHandler func(tx *sqlx.Tx, event *OutboxEvent) error
+
+// OutboxFunc はトランザクションの外でイベントを処理する。外部への HTTP リクエストのように時間のかかる処理に使う。
+// 処理済みのマークは OutboxFunc が返ってから短いクエリで書き込むので、待っている間に行ロックを持ち続けない。
+// リース (OutboxLease) が切れると別のワーカーが同じイベントを取りうるので、処理はリースより十分短く終わらせること。
+type OutboxFunc func(db *sqlx.DB, event *OutboxEvent) error
+
+// Outbox は notification_outbox テーブルを使った永続キュー。
+// 未処理のイベントはテーブルに残るので、プロセスが再起動しても Run を呼べば続きから処理される。
+type Outbox struct {
+	mu        sync.Mutex
+	wakeup    chan struct{}
+	processed chan struct{}
+	handlers  map[string]OutboxHandler
+	funcs     map[string]OutboxFunc
+}
+
+func (o *Outbox) Handle(kind string, handler OutboxHandler) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.handlers == nil {
+		o.handlers = make(map[string]OutboxHandler)
+	}
+	o.handlers[kind] = handler
+}
+
+func (o *Outbox) HandleFunc(kind string, f OutboxFunc) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.funcs == nil {
+		o.funcs = make(map[string]OutboxFunc)
+	}
+	o.funcs[kind] = f
+}
+
+func (o *Outbox) handler(kind string) (OutboxHandler, OutboxFunc) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.handlers[kind], o.funcs[kind]
+}
+
+func (o *Outbox) wakeupChan() chan struct{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.wakeup == nil {
+		o.wakeup = make(chan struct{}, 1)
+	}
+	return o.wakeup
+}
+
+// Enqueue はイベントを 1 件積む。db にトランザクションを渡せば、呼び出し元の更新と同時にコミットされる。
+func (o *Outbox) Enqueue(db sqlx.Execer, kind string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+	_, err = db.Exec(
+		"INSERT INTO `notification_outbox` (`kind`, `payload`, `attempts`, `next_attempt_at`, `created_at`, `updated_at`) VALUES (?, ?, 0, NOW(6), NOW(6), NOW(6))",
+		kind,
+		string(b),
+	)
+	if err != nil {
+		return fmt.Errorf("insert outbox event: %w", err)
+	}
+	return nil
+}
+
+// Wakeup は待機中の Run をすぐに起こす。Enqueue は起こさないので、積んだトランザクションをコミットしてから呼ぶこと。
+// コミット前に起こすと Run がまだ見えないイベントを取りに行き、次のポーリングまで処理が遅れる。
+func (o *Outbox) Wakeup() {
+	select {
+	case o.wakeupChan() <- struct{}{}:
+	default:
+	}
+}
+
+// Processed は次にこのプロセスでイベントの処理がコミットされたときに close されるチャネルを返す。
+// 別プロセスの Run が処理したイベントでは close されないので、待つ側はポーリングと併用すること。
+func (o *Outbox) Processed() <-chan struct{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.processed == nil {
+		o.processed = make(chan struct{})
+	}
+	return o.processed
+}
+
+func (o *Outbox) broadcastProcessed() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.processed != nil {
+		close(o.processed)
+		o.processed = nil
+	}
+}
+
+// Run は ctx がキャンセルされるまで workers 個の goroutine でイベントを処理し続ける。
+func (o *Outbox) Run(ctx context.Context, db *sqlx.DB, workers int) {
+	if workers <= 0 {
+		workers = 1
+	}
+	events := make(chan *OutboxEvent)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := range events {
+				o.process(db, event)
+			}
+		}()
+	}
+	defer wg.Wait()
+	defer close(events)
+
+	ticker := time.NewTicker(OutboxPollInterval)
+	defer ticker.Stop()
+	wakeup := o.wakeupChan()
+	for {
+		claimed, err := o.claim(db)
+		if err != nil {
+			log.Printf("[WARN] claim outbox events: %v", err)
+		}
+		for _, event := range claimed {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if len(claimed) == OutboxBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-wakeup:
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim は処理可能なイベントにリースを付けて取得する。複数プロセスで Run しても同じイベントを二重に取らない。
+func (o *Outbox) claim(db *sqlx.DB) ([]*OutboxEvent, error) {
+	var candidates []*OutboxEvent
+	err := db.Select(
+		&candidates,
+		"SELECT * FROM `notification_outbox` WHERE `processed_at` IS NULL AND `next_attempt_at` <= NOW(6) AND (`locked_until` IS NULL OR `locked_until` <= NOW(6)) ORDER BY `id` LIMIT ?",
+		OutboxBatchSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select outbox events: %w", err)
+	}
+	var claimed []*OutboxEvent
+	for _, event := range candidates {
+		res, err := db.Exec(
+			"UPDATE `notification_outbox` SET `locked_until` = TIMESTAMPADD(MICROSECOND, ?, NOW(6)), `updated_at` = NOW(6) WHERE `id` = ? AND `processed_at` IS NULL AND (`locked_until` IS NULL OR `locked_until` <= NOW(6)) LIMIT 1",
+			OutboxLease.Microseconds(),
+			event.ID,
+		)
+		if err != nil {
+			return claimed, fmt.Errorf("lock outbox event(id=%v): %w", event.ID, err)
+		}
+		if n, _ := res.RowsAffected(); n == 1 {
+			claimed = append(claimed, event)
+		}
+	}
+	return claimed, nil
+}
+
+func (o *Outbox) process(db *sqlx.DB, event *OutboxEvent) {
+	err := o.handle(db, event)
+	if err == nil {
+		return
+	}
+	attempts := event.Attempts + 1
+	log.Printf("[WARN] outbox event(id=%v, kind=%v, attempts=%v): %v", event.ID, event.Kind, attempts, err)
+	lastError := err.Error()
+	if len(lastError) > 255 {
+		lastError = lastError[:255]
+	}
+	if attempts >= OutboxMaxAttempts {
+		_, err = db.Exec(
+			"UPDATE `notification_outbox` SET `attempts` = ?, `last_error` = ?, `locked_until` = NULL, `processed_at` = NOW(6), `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+			attempts,
+			lastError,
+			event.ID,
+		)
+	} else {
+		_, err = db.Exec(
+			"UPDATE `notification_outbox` SET `attempts` = ?, `last_error` = ?, `locked_until` = NULL, `next_attempt_at` = TIMESTAMPADD(MICROSECOND, ?, NOW(6)), `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+			attempts,
+			lastError,
+			outboxBackoff(attempts).Microseconds(),
+			event.ID,
+		)
+	}
+	if err != nil {
+		log.Printf("[ERROR] reschedule outbox event(id=%v): %v", event.ID, err)
+	}
+}
+
+func (o *Outbox) handle(db *sqlx.DB, event *OutboxEvent) error {
+	handler, f := o.handler(event.Kind)
+	if f != nil {
+		if err := f(db, event); err != nil {
+			return err
+		}
+		_, err := db.Exec(
+			"UPDATE `notification_outbox` SET `attempts` = `attempts` + 1, `locked_until` = NULL, `processed_at` = NOW(6), `updated_at` = NOW(6) WHERE `id` = ? AND `processed_at` IS NULL LIMIT 1",
+			event.ID,
+		)
+		if err != nil {
+			return fmt.Errorf("mark outbox event as processed: %w", err)
+		}
+		o.broadcastProcessed()
+		return nil
+	}
+	if handler == nil {
+		return fmt.Errorf("unknown kind: %q", event.Kind)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	if err := handler(tx, event); err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		"UPDATE `notification_outbox` SET `attempts` = `attempts` + 1, `locked_until` = NULL, `processed_at` = NOW(6), `updated_at` = NOW(6) WHERE `id` = ? LIMIT 1",
+		event.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("mark outbox event as processed: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	// handler が積んだイベント (web_push など) をすぐに処理する
+	o.Wakeup()
+	o.broadcastProcessed()
+	return nil
+}
+
+func outboxBackoff(attempts int) time.Duration {
+	backoff := time.Second << uint(attempts)
+	if backoff <= 0 || backoff > OutboxMaxBackoff {
+		return OutboxMaxBackoff
+	}
+	return backoff
+}
+
+func (e *OutboxEvent) Decode(v interface{}) error {
+	if err := json.Unmarshal([]byte(e.Payload), v); err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+	return nil
+}
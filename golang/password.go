@@ -0,0 +1,67 @@
+package xsuportal
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// contestants.password は "<アルゴリズム>:<ハッシュ>" の形式で保存する。
+// プレフィックスのない値は以前の hex(sha256(password)) として扱い、ログインに成功したときに作り直す。
+const (
+	PasswordAlgorithmBcrypt = "bcrypt"
+)
+
+// PasswordHasher は新しく保存するパスワードのハッシュを作り、保存済みのハッシュを検証する。
+type PasswordHasher struct {
+	BcryptCost int
+}
+
+func (h *PasswordHasher) cost() int {
+	if h.BcryptCost == 0 {
+		return bcrypt.DefaultCost
+	}
+	return h.BcryptCost
+}
+
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(password), h.cost())
+	if err != nil {
+		return "", fmt.Errorf("generate bcrypt hash: %w", err)
+	}
+	return PasswordAlgorithmBcrypt + ":" + string(b), nil
+}
+
+// Verify は password が hashed と一致するかを返す。
+// 一致していてもハッシュが古い形式や弱いコストなら needsRehash を true にするので、呼び出し元で Hash し直して保存すること。
+func (h *PasswordHasher) Verify(hashed string, password string) (ok bool, needsRehash bool, err error) {
+	algorithm, digest := "", hashed
+	if i := strings.IndexByte(hashed, ':'); i >= 0 {
+		algorithm, digest = hashed[:i], hashed[i+1:]
+	}
+	switch algorithm {
+	case PasswordAlgorithmBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(digest), []byte(password))
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, false, nil
+		}
+		if err != nil {
+			return false, false, fmt.Errorf("compare bcrypt hash: %w", err)
+		}
+		cost, err := bcrypt.Cost([]byte(digest))
+		if err != nil {
+			return true, true, nil
+		}
+		return true, cost < h.cost(), nil
+	case "":
+		sum := sha256.Sum256([]byte(password))
+		ok := subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(digest)) == 1
+		return ok, ok, nil
+	default:
+		return false, false, fmt.Errorf("unknown password algorithm: %q", algorithm)
+	}
+}
@@ -0,0 +1,70 @@
+package xsuportal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordHasherVerify(t *testing.T) {
+	hasher := &PasswordHasher{BcryptCost: bcrypt.MinCost}
+	current, err := hasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weak, err := (&PasswordHasher{BcryptCost: bcrypt.MinCost}).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte("password"))
+	legacy := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name        string
+		hasher      *PasswordHasher
+		hashed      string
+		password    string
+		ok          bool
+		needsRehash bool
+		err         bool
+	}{
+		{name: "bcrypt", hasher: hasher, hashed: current, password: "password", ok: true},
+		{name: "bcrypt wrong password", hasher: hasher, hashed: current, password: "wrong"},
+		{name: "bcrypt weak cost", hasher: &PasswordHasher{BcryptCost: bcrypt.MinCost + 1}, hashed: weak, password: "password", ok: true, needsRehash: true},
+		{name: "legacy sha256", hasher: hasher, hashed: legacy, password: "password", ok: true, needsRehash: true},
+		{name: "legacy sha256 wrong password", hasher: hasher, hashed: legacy, password: "wrong"},
+		{name: "broken bcrypt", hasher: hasher, hashed: "bcrypt:broken", password: "password", err: true},
+		{name: "unknown algorithm", hasher: hasher, hashed: "md5:" + legacy, password: "password", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := tt.hasher.Verify(tt.hashed, tt.password)
+			if (err != nil) != tt.err {
+				t.Fatalf("err = %v, want err: %v", err, tt.err)
+			}
+			if ok != tt.ok || needsRehash != tt.needsRehash {
+				t.Errorf("Verify() = (%v, %v), want (%v, %v)", ok, needsRehash, tt.ok, tt.needsRehash)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherHash(t *testing.T) {
+	hasher := &PasswordHasher{BcryptCost: bcrypt.MinCost}
+	a, err := hasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := hasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Errorf("Hash() returned the same hash twice: %s", a)
+	}
+	if a[:len(PasswordAlgorithmBcrypt)+1] != PasswordAlgorithmBcrypt+":" {
+		t.Errorf("Hash() = %s, want %s: prefix", a, PasswordAlgorithmBcrypt)
+	}
+}
@@ -0,0 +1,69 @@
+package xsuportal
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	NotificationContentBenchmarkJob           = "benchmark_job"
+	NotificationContentOwnClarification       = "own_clarification"
+	NotificationContentDisclosedClarification = "disclosed_clarification"
+)
+
+var NotificationContentTypes = []string{
+	NotificationContentBenchmarkJob,
+	NotificationContentOwnClarification,
+	NotificationContentDisclosedClarification,
+}
+
+func IsNotificationContentType(contentType string) bool {
+	for _, t := range NotificationContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationPreferences は contestantID ごとに content type をキーにした設定を持つ。
+type NotificationPreferences map[string]map[string]NotificationPreference
+
+// Get は設定を返す。設定していない組み合わせはどちらのチャネルも有効とみなす。
+func (p NotificationPreferences) Get(contestantID string, contentType string) NotificationPreference {
+	if pref, ok := p[contestantID][contentType]; ok {
+		return pref
+	}
+	return NotificationPreference{
+		ContestantID: contestantID,
+		ContentType:  contentType,
+		InApp:        true,
+		WebPush:      true,
+	}
+}
+
+func GetNotificationPreferences(db sqlx.Queryer, contestantIDs []string) (NotificationPreferences, error) {
+	prefs := make(NotificationPreferences, len(contestantIDs))
+	if len(contestantIDs) == 0 {
+		return prefs, nil
+	}
+	query, params, err := sqlx.In(
+		"SELECT * FROM `notification_preferences` WHERE `contestant_id` IN (?)",
+		contestantIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+	var rows []NotificationPreference
+	if err := sqlx.Select(db, &rows, query, params...); err != nil {
+		return nil, fmt.Errorf("select notification preferences: %w", err)
+	}
+	for _, row := range rows {
+		if prefs[row.ContestantID] == nil {
+			prefs[row.ContestantID] = make(map[string]NotificationPreference)
+		}
+		prefs[row.ContestantID][row.ContentType] = row
+	}
+	return prefs, nil
+}
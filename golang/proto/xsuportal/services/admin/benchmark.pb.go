@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.25.0
-// 	protoc        v3.12.4
+// 	protoc        v3.14.0
 // source: xsuportal/services/admin/benchmark.proto
 
 package admin
@@ -422,6 +422,165 @@ func (x *GetBenchmarkJobResponse) GetJob() *resources.BenchmarkJob {
 	return nil
 }
 
+type ListBenchmarkQueueWaitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBenchmarkQueueWaitsRequest) Reset() {
+	*x = ListBenchmarkQueueWaitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBenchmarkQueueWaitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkQueueWaitsRequest) ProtoMessage() {}
+
+func (x *ListBenchmarkQueueWaitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkQueueWaitsRequest.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkQueueWaitsRequest) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_benchmark_proto_rawDescGZIP(), []int{8}
+}
+
+// Time each team's jobs waited in the queue, from created_at to dispatched_at.
+// A requeued job counts the wait from its original created_at.
+type ListBenchmarkQueueWaitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Teams []*ListBenchmarkQueueWaitsResponse_TeamQueueWait `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *ListBenchmarkQueueWaitsResponse) Reset() {
+	*x = ListBenchmarkQueueWaitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBenchmarkQueueWaitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkQueueWaitsResponse) ProtoMessage() {}
+
+func (x *ListBenchmarkQueueWaitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkQueueWaitsResponse.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkQueueWaitsResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_benchmark_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBenchmarkQueueWaitsResponse) GetTeams() []*ListBenchmarkQueueWaitsResponse_TeamQueueWait {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type ListBenchmarkQueueWaitsResponse_TeamQueueWait struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TeamId int64 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// number of dispatched jobs
+	Count                   int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	AverageWaitMicroseconds int64 `protobuf:"varint,3,opt,name=average_wait_microseconds,json=averageWaitMicroseconds,proto3" json:"average_wait_microseconds,omitempty"`
+	MaxWaitMicroseconds     int64 `protobuf:"varint,4,opt,name=max_wait_microseconds,json=maxWaitMicroseconds,proto3" json:"max_wait_microseconds,omitempty"`
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) Reset() {
+	*x = ListBenchmarkQueueWaitsResponse_TeamQueueWait{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBenchmarkQueueWaitsResponse_TeamQueueWait) ProtoMessage() {}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_benchmark_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBenchmarkQueueWaitsResponse_TeamQueueWait.ProtoReflect.Descriptor instead.
+func (*ListBenchmarkQueueWaitsResponse_TeamQueueWait) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_benchmark_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) GetAverageWaitMicroseconds() int64 {
+	if x != nil {
+		return x.AverageWaitMicroseconds
+	}
+	return 0
+}
+
+func (x *ListBenchmarkQueueWaitsResponse_TeamQueueWait) GetMaxWaitMicroseconds() int64 {
+	if x != nil {
+		return x.MaxWaitMicroseconds
+	}
+	return 0
+}
+
 var File_xsuportal_services_admin_benchmark_proto protoreflect.FileDescriptor
 
 var file_xsuportal_services_admin_benchmark_proto_rawDesc = []byte{
@@ -470,13 +629,35 @@ var file_xsuportal_services_admin_benchmark_proto_rawDesc = []byte{
 	0x73, 0x65, 0x12, 0x39, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x27, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x65, 0x6e, 0x63,
-	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x42, 0x4f, 0x5a,
-	0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x73, 0x75, 0x63,
-	0x6f, 0x6e, 0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x31, 0x30, 0x2d, 0x66, 0x69, 0x6e, 0x61,
-	0x6c, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x20, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xb7, 0x02, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69,
+	0x74, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x61,
+	0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x57, 0x61, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x4d, 0x69, 0x63,
+	0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x2f, 0x69,
+	0x73, 0x75, 0x63, 0x6f, 0x6e, 0x31, 0x30, 0x2d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x65,
+	0x62, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -491,28 +672,32 @@ func file_xsuportal_services_admin_benchmark_proto_rawDescGZIP() []byte {
 	return file_xsuportal_services_admin_benchmark_proto_rawDescData
 }
 
-var file_xsuportal_services_admin_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_xsuportal_services_admin_benchmark_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_xsuportal_services_admin_benchmark_proto_goTypes = []interface{}{
-	(*ListBenchmarkJobsRequest)(nil),    // 0: xsuportal.proto.services.admin.ListBenchmarkJobsRequest
-	(*ListBenchmarkJobsResponse)(nil),   // 1: xsuportal.proto.services.admin.ListBenchmarkJobsResponse
-	(*EnqueueBenchmarkJobRequest)(nil),  // 2: xsuportal.proto.services.admin.EnqueueBenchmarkJobRequest
-	(*EnqueueBenchmarkJobResponse)(nil), // 3: xsuportal.proto.services.admin.EnqueueBenchmarkJobResponse
-	(*CancelBenchmarkJobRequest)(nil),   // 4: xsuportal.proto.services.admin.CancelBenchmarkJobRequest
-	(*CancelBenchmarkJobResponse)(nil),  // 5: xsuportal.proto.services.admin.CancelBenchmarkJobResponse
-	(*GetBenchmarkJobQuery)(nil),        // 6: xsuportal.proto.services.admin.GetBenchmarkJobQuery
-	(*GetBenchmarkJobResponse)(nil),     // 7: xsuportal.proto.services.admin.GetBenchmarkJobResponse
-	(*resources.BenchmarkJob)(nil),      // 8: xsuportal.proto.resources.BenchmarkJob
+	(*ListBenchmarkJobsRequest)(nil),                      // 0: xsuportal.proto.services.admin.ListBenchmarkJobsRequest
+	(*ListBenchmarkJobsResponse)(nil),                     // 1: xsuportal.proto.services.admin.ListBenchmarkJobsResponse
+	(*EnqueueBenchmarkJobRequest)(nil),                    // 2: xsuportal.proto.services.admin.EnqueueBenchmarkJobRequest
+	(*EnqueueBenchmarkJobResponse)(nil),                   // 3: xsuportal.proto.services.admin.EnqueueBenchmarkJobResponse
+	(*CancelBenchmarkJobRequest)(nil),                     // 4: xsuportal.proto.services.admin.CancelBenchmarkJobRequest
+	(*CancelBenchmarkJobResponse)(nil),                    // 5: xsuportal.proto.services.admin.CancelBenchmarkJobResponse
+	(*GetBenchmarkJobQuery)(nil),                          // 6: xsuportal.proto.services.admin.GetBenchmarkJobQuery
+	(*GetBenchmarkJobResponse)(nil),                       // 7: xsuportal.proto.services.admin.GetBenchmarkJobResponse
+	(*ListBenchmarkQueueWaitsRequest)(nil),                // 8: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsRequest
+	(*ListBenchmarkQueueWaitsResponse)(nil),               // 9: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse
+	(*ListBenchmarkQueueWaitsResponse_TeamQueueWait)(nil), // 10: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait
+	(*resources.BenchmarkJob)(nil),                        // 11: xsuportal.proto.resources.BenchmarkJob
 }
 var file_xsuportal_services_admin_benchmark_proto_depIdxs = []int32{
-	8, // 0: xsuportal.proto.services.admin.ListBenchmarkJobsResponse.jobs:type_name -> xsuportal.proto.resources.BenchmarkJob
-	8, // 1: xsuportal.proto.services.admin.EnqueueBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
-	8, // 2: xsuportal.proto.services.admin.CancelBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
-	8, // 3: xsuportal.proto.services.admin.GetBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	11, // 0: xsuportal.proto.services.admin.ListBenchmarkJobsResponse.jobs:type_name -> xsuportal.proto.resources.BenchmarkJob
+	11, // 1: xsuportal.proto.services.admin.EnqueueBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
+	11, // 2: xsuportal.proto.services.admin.CancelBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
+	11, // 3: xsuportal.proto.services.admin.GetBenchmarkJobResponse.job:type_name -> xsuportal.proto.resources.BenchmarkJob
+	10, // 4: xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.teams:type_name -> xsuportal.proto.services.admin.ListBenchmarkQueueWaitsResponse.TeamQueueWait
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_xsuportal_services_admin_benchmark_proto_init() }
@@ -617,6 +802,42 @@ func file_xsuportal_services_admin_benchmark_proto_init() {
 				return nil
 			}
 		}
+		file_xsuportal_services_admin_benchmark_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBenchmarkQueueWaitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_benchmark_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBenchmarkQueueWaitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_benchmark_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBenchmarkQueueWaitsResponse_TeamQueueWait); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -624,7 +845,7 @@ func file_xsuportal_services_admin_benchmark_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xsuportal_services_admin_benchmark_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,428 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: xsuportal/services/admin/contests.proto
+
+package admin
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	resources "github.com/isucon/isucon10-final/webapp/golang/proto/xsuportal/resources"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ListContestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contests []*resources.Contest `protobuf:"bytes,1,rep,name=contests,proto3" json:"contests,omitempty"`
+}
+
+func (x *ListContestsResponse) Reset() {
+	*x = ListContestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_contests_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContestsResponse) ProtoMessage() {}
+
+func (x *ListContestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_contests_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContestsResponse.ProtoReflect.Descriptor instead.
+func (*ListContestsResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_contests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListContestsResponse) GetContests() []*resources.Contest {
+	if x != nil {
+		return x.Contests
+	}
+	return nil
+}
+
+type CreateContestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *resources.Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *CreateContestRequest) Reset() {
+	*x = CreateContestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_contests_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContestRequest) ProtoMessage() {}
+
+func (x *CreateContestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_contests_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContestRequest.ProtoReflect.Descriptor instead.
+func (*CreateContestRequest) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_contests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateContestRequest) GetContest() *resources.Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type CreateContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *resources.Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *CreateContestResponse) Reset() {
+	*x = CreateContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_contests_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContestResponse) ProtoMessage() {}
+
+func (x *CreateContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_contests_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContestResponse.ProtoReflect.Descriptor instead.
+func (*CreateContestResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_contests_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateContestResponse) GetContest() *resources.Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type ActivateContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *resources.Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *ActivateContestResponse) Reset() {
+	*x = ActivateContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_contests_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateContestResponse) ProtoMessage() {}
+
+func (x *ActivateContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_contests_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateContestResponse.ProtoReflect.Descriptor instead.
+func (*ActivateContestResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_contests_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivateContestResponse) GetContest() *resources.Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+type ArchiveContestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contest *resources.Contest `protobuf:"bytes,1,opt,name=contest,proto3" json:"contest,omitempty"`
+}
+
+func (x *ArchiveContestResponse) Reset() {
+	*x = ArchiveContestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_contests_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveContestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveContestResponse) ProtoMessage() {}
+
+func (x *ArchiveContestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_contests_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveContestResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveContestResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_contests_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ArchiveContestResponse) GetContest() *resources.Contest {
+	if x != nil {
+		return x.Contest
+	}
+	return nil
+}
+
+var File_xsuportal_services_admin_contests_proto protoreflect.FileDescriptor
+
+var file_xsuportal_services_admin_contests_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x78, 0x73, 0x75, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x21, 0x78, 0x73, 0x75, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x22, 0x57, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x73, 0x74, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x31, 0x30,
+	0x2d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x78, 0x73, 0x75, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xsuportal_services_admin_contests_proto_rawDescOnce sync.Once
+	file_xsuportal_services_admin_contests_proto_rawDescData = file_xsuportal_services_admin_contests_proto_rawDesc
+)
+
+func file_xsuportal_services_admin_contests_proto_rawDescGZIP() []byte {
+	file_xsuportal_services_admin_contests_proto_rawDescOnce.Do(func() {
+		file_xsuportal_services_admin_contests_proto_rawDescData = protoimpl.X.CompressGZIP(file_xsuportal_services_admin_contests_proto_rawDescData)
+	})
+	return file_xsuportal_services_admin_contests_proto_rawDescData
+}
+
+var file_xsuportal_services_admin_contests_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_xsuportal_services_admin_contests_proto_goTypes = []interface{}{
+	(*ListContestsResponse)(nil),    // 0: xsuportal.proto.services.admin.ListContestsResponse
+	(*CreateContestRequest)(nil),    // 1: xsuportal.proto.services.admin.CreateContestRequest
+	(*CreateContestResponse)(nil),   // 2: xsuportal.proto.services.admin.CreateContestResponse
+	(*ActivateContestResponse)(nil), // 3: xsuportal.proto.services.admin.ActivateContestResponse
+	(*ArchiveContestResponse)(nil),  // 4: xsuportal.proto.services.admin.ArchiveContestResponse
+	(*resources.Contest)(nil),       // 5: xsuportal.proto.resources.Contest
+}
+var file_xsuportal_services_admin_contests_proto_depIdxs = []int32{
+	5, // 0: xsuportal.proto.services.admin.ListContestsResponse.contests:type_name -> xsuportal.proto.resources.Contest
+	5, // 1: xsuportal.proto.services.admin.CreateContestRequest.contest:type_name -> xsuportal.proto.resources.Contest
+	5, // 2: xsuportal.proto.services.admin.CreateContestResponse.contest:type_name -> xsuportal.proto.resources.Contest
+	5, // 3: xsuportal.proto.services.admin.ActivateContestResponse.contest:type_name -> xsuportal.proto.resources.Contest
+	5, // 4: xsuportal.proto.services.admin.ArchiveContestResponse.contest:type_name -> xsuportal.proto.resources.Contest
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_xsuportal_services_admin_contests_proto_init() }
+func file_xsuportal_services_admin_contests_proto_init() {
+	if File_xsuportal_services_admin_contests_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xsuportal_services_admin_contests_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_contests_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_contests_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_contests_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateContestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_contests_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveContestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xsuportal_services_admin_contests_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xsuportal_services_admin_contests_proto_goTypes,
+		DependencyIndexes: file_xsuportal_services_admin_contests_proto_depIdxs,
+		MessageInfos:      file_xsuportal_services_admin_contests_proto_msgTypes,
+	}.Build()
+	File_xsuportal_services_admin_contests_proto = out.File
+	file_xsuportal_services_admin_contests_proto_rawDesc = nil
+	file_xsuportal_services_admin_contests_proto_goTypes = nil
+	file_xsuportal_services_admin_contests_proto_depIdxs = nil
+}
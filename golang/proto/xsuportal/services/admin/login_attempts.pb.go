@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: xsuportal/services/admin/login_attempts.proto
+
+package admin
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ListLoginAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginAttempts []*ListLoginAttemptsResponse_LoginAttempt `protobuf:"bytes,1,rep,name=login_attempts,json=loginAttempts,proto3" json:"login_attempts,omitempty"`
+}
+
+func (x *ListLoginAttemptsResponse) Reset() {
+	*x = ListLoginAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_login_attempts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoginAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginAttemptsResponse) ProtoMessage() {}
+
+func (x *ListLoginAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_login_attempts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_login_attempts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListLoginAttemptsResponse) GetLoginAttempts() []*ListLoginAttemptsResponse_LoginAttempt {
+	if x != nil {
+		return x.LoginAttempts
+	}
+	return nil
+}
+
+type ListLoginAttemptsResponse_LoginAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope        string               `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Key          string               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Failures     int64                `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LockedUntil  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	LastFailedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) Reset() {
+	*x = ListLoginAttemptsResponse_LoginAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xsuportal_services_admin_login_attempts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginAttemptsResponse_LoginAttempt) ProtoMessage() {}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_xsuportal_services_admin_login_attempts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginAttemptsResponse_LoginAttempt.ProtoReflect.Descriptor instead.
+func (*ListLoginAttemptsResponse_LoginAttempt) Descriptor() ([]byte, []int) {
+	return file_xsuportal_services_admin_login_attempts_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) GetLockedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+func (x *ListLoginAttemptsResponse_LoginAttempt) GetLastFailedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+var File_xsuportal_services_admin_login_attempts_proto protoreflect.FileDescriptor
+
+var file_xsuportal_services_admin_login_attempts_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe0, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x78, 0x73, 0x75, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0d,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x1a, 0xd3, 0x01,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x2f, 0x69, 0x73, 0x75, 0x63, 0x6f, 0x6e, 0x31,
+	0x30, 0x2d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x70, 0x70, 0x2f, 0x67,
+	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x78, 0x73, 0x75, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xsuportal_services_admin_login_attempts_proto_rawDescOnce sync.Once
+	file_xsuportal_services_admin_login_attempts_proto_rawDescData = file_xsuportal_services_admin_login_attempts_proto_rawDesc
+)
+
+func file_xsuportal_services_admin_login_attempts_proto_rawDescGZIP() []byte {
+	file_xsuportal_services_admin_login_attempts_proto_rawDescOnce.Do(func() {
+		file_xsuportal_services_admin_login_attempts_proto_rawDescData = protoimpl.X.CompressGZIP(file_xsuportal_services_admin_login_attempts_proto_rawDescData)
+	})
+	return file_xsuportal_services_admin_login_attempts_proto_rawDescData
+}
+
+var file_xsuportal_services_admin_login_attempts_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_xsuportal_services_admin_login_attempts_proto_goTypes = []interface{}{
+	(*ListLoginAttemptsResponse)(nil),              // 0: xsuportal.proto.services.admin.ListLoginAttemptsResponse
+	(*ListLoginAttemptsResponse_LoginAttempt)(nil), // 1: xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt
+	(*timestamp.Timestamp)(nil),                    // 2: google.protobuf.Timestamp
+}
+var file_xsuportal_services_admin_login_attempts_proto_depIdxs = []int32{
+	1, // 0: xsuportal.proto.services.admin.ListLoginAttemptsResponse.login_attempts:type_name -> xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt
+	2, // 1: xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt.locked_until:type_name -> google.protobuf.Timestamp
+	2, // 2: xsuportal.proto.services.admin.ListLoginAttemptsResponse.LoginAttempt.last_failed_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_xsuportal_services_admin_login_attempts_proto_init() }
+func file_xsuportal_services_admin_login_attempts_proto_init() {
+	if File_xsuportal_services_admin_login_attempts_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xsuportal_services_admin_login_attempts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoginAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xsuportal_services_admin_login_attempts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoginAttemptsResponse_LoginAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xsuportal_services_admin_login_attempts_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xsuportal_services_admin_login_attempts_proto_goTypes,
+		DependencyIndexes: file_xsuportal_services_admin_login_attempts_proto_depIdxs,
+		MessageInfos:      file_xsuportal_services_admin_login_attempts_proto_msgTypes,
+	}.Build()
+	File_xsuportal_services_admin_login_attempts_proto = out.File
+	file_xsuportal_services_admin_login_attempts_proto_rawDesc = nil
+	file_xsuportal_services_admin_login_attempts_proto_goTypes = nil
+	file_xsuportal_services_admin_login_attempts_proto_depIdxs = nil
+}
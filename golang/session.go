@@ -0,0 +1,238 @@
+package xsuportal
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/securecookie"
+	"github.com/gorilla/sessions"
+	"github.com/jmoiron/sqlx"
+)
+
+const SessionCleanupInterval = 10 * time.Minute
+
+// SessionStore は sessions テーブルにセッションを保存する sessions.Store。
+// Cookie には署名したセッション ID だけを載せる。
+// 署名には Secrets の先頭を使い、検証には全部を試すので、新しい鍵を先頭に足せばログイン中のセッションを切らずにローテーションできる。
+// MaxAge は最後のアクセスからの有効期限で、アクセスのたびに延長される (Touch を参照)。
+type SessionStore struct {
+	DB      *sqlx.DB
+	Options *sessions.Options
+	// TouchInterval より短い間隔のアクセスでは有効期限を延長しない
+	TouchInterval time.Duration
+
+	codecs []securecookie.Codec
+}
+
+type Session struct {
+	ID           string         `db:"id"`
+	ContestantID sql.NullString `db:"contestant_id"`
+	Data         []byte         `db:"data"`
+	ExpiresAt    time.Time      `db:"expires_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+// sessionExpiresAtKey は読み込んだセッションの有効期限を Values に持たせておくためのキーで、保存はされない
+type sessionExpiresAtKey struct{}
+
+func NewSessionStore(db *sqlx.DB, secrets [][]byte, maxAge time.Duration) *SessionStore {
+	keyPairs := make([][]byte, 0, len(secrets)*2)
+	for _, secret := range secrets {
+		keyPairs = append(keyPairs, secret, nil)
+	}
+	return &SessionStore{
+		DB: db,
+		Options: &sessions.Options{
+			Path:     "/",
+			MaxAge:   int(maxAge / time.Second),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		},
+		TouchInterval: time.Minute,
+		codecs:        securecookie.CodecsFromPairs(keyPairs...),
+	}
+}
+
+func (s *SessionStore) Get(r *http.Request, name string) (*sessions.Session, error) {
+	return sessions.GetRegistry(r).Get(s, name)
+}
+
+// New は Cookie のセッションを読み込む。Cookie がないか、期限切れや削除済みのセッションなら空のセッションを返す。
+func (s *SessionStore) New(r *http.Request, name string) (*sessions.Session, error) {
+	session := sessions.NewSession(s, name)
+	opts := *s.Options
+	session.Options = &opts
+	session.IsNew = true
+	c, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+	var id string
+	if err := securecookie.DecodeMulti(name, c.Value, &id, s.codecs...); err != nil {
+		return session, nil
+	}
+	var row Session
+	err = s.DB.Get(
+		&row,
+		"SELECT * FROM `sessions` WHERE `id` = ? AND `expires_at` > NOW(6) LIMIT 1",
+		id,
+	)
+	if err == sql.ErrNoRows {
+		return session, nil
+	}
+	if err != nil {
+		return session, fmt.Errorf("get session: %w", err)
+	}
+	if err := (securecookie.GobEncoder{}).Deserialize(row.Data, &session.Values); err != nil {
+		return session, fmt.Errorf("deserialize session: %w", err)
+	}
+	session.ID = row.ID
+	session.Values[sessionExpiresAtKey{}] = row.ExpiresAt
+	session.IsNew = false
+	return session, nil
+}
+
+// Save はセッションを保存して Cookie を送る。Options.MaxAge が負ならセッションを削除する。
+func (s *SessionStore) Save(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.Options.MaxAge < 0 {
+		if session.ID != "" {
+			if err := s.Delete(session.ID); err != nil {
+				return err
+			}
+		}
+		http.SetCookie(w, sessions.NewCookie(session.Name(), "", session.Options))
+		return nil
+	}
+	if session.ID == "" {
+		id, err := newSessionID()
+		if err != nil {
+			return err
+		}
+		session.ID = id
+	}
+	values := make(map[interface{}]interface{}, len(session.Values))
+	for k, v := range session.Values {
+		if _, ok := k.(sessionExpiresAtKey); !ok {
+			values[k] = v
+		}
+	}
+	data, err := (securecookie.GobEncoder{}).Serialize(values)
+	if err != nil {
+		return fmt.Errorf("serialize session: %w", err)
+	}
+	var contestantID sql.NullString
+	if id, ok := session.Values["contestant_id"].(string); ok {
+		contestantID = sql.NullString{String: id, Valid: true}
+	}
+	maxAge := time.Duration(session.Options.MaxAge) * time.Second
+	_, err = s.DB.Exec(
+		"INSERT INTO `sessions` (`id`, `contestant_id`, `data`, `expires_at`, `created_at`, `updated_at`) VALUES (?, ?, ?, TIMESTAMPADD(MICROSECOND, ?, NOW(6)), NOW(6), NOW(6)) ON DUPLICATE KEY UPDATE `contestant_id` = VALUES(`contestant_id`), `data` = VALUES(`data`), `expires_at` = VALUES(`expires_at`), `updated_at` = NOW(6)",
+		session.ID,
+		contestantID,
+		data,
+		maxAge.Microseconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+	session.Values[sessionExpiresAtKey{}] = time.Now().Add(maxAge)
+	encoded, err := securecookie.EncodeMulti(session.Name(), session.ID, s.codecs...)
+	if err != nil {
+		return fmt.Errorf("encode session id: %w", err)
+	}
+	http.SetCookie(w, sessions.NewCookie(session.Name(), encoded, session.Options))
+	return nil
+}
+
+// Renew はセッション ID を振り直して保存する。ログイン時のセッション固定攻撃を防ぐために使う。
+func (s *SessionStore) Renew(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.ID != "" {
+		if err := s.Delete(session.ID); err != nil {
+			return err
+		}
+		session.ID = ""
+	}
+	return s.Save(r, w, session)
+}
+
+// Touch は最後に延長してから TouchInterval 以上経っていれば有効期限を延長する。
+func (s *SessionStore) Touch(r *http.Request, w http.ResponseWriter, session *sessions.Session) error {
+	if session.IsNew || session.ID == "" {
+		return nil
+	}
+	expiresAt, ok := session.Values[sessionExpiresAtKey{}].(time.Time)
+	maxAge := time.Duration(session.Options.MaxAge) * time.Second
+	if ok && time.Until(expiresAt) > maxAge-s.TouchInterval {
+		return nil
+	}
+	return s.Save(r, w, session)
+}
+
+func (s *SessionStore) Delete(id string) error {
+	_, err := s.DB.Exec("DELETE FROM `sessions` WHERE `id` = ? LIMIT 1", id)
+	if err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+	return nil
+}
+
+// ListByContestant はコンテスタントの有効なセッションを最後にアクセスした順に返す。
+func (s *SessionStore) ListByContestant(contestantID string) ([]Session, error) {
+	var rows []Session
+	err := s.DB.Select(
+		&rows,
+		"SELECT * FROM `sessions` WHERE `contestant_id` = ? AND `expires_at` > NOW(6) ORDER BY `updated_at` DESC",
+		contestantID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select sessions: %w", err)
+	}
+	return rows, nil
+}
+
+// RevokeByContestant はコンテスタントのセッションを削除する。id が空なら全部削除する。
+func (s *SessionStore) RevokeByContestant(contestantID string, id string) (int64, error) {
+	var res sql.Result
+	var err error
+	if id == "" {
+		res, err = s.DB.Exec("DELETE FROM `sessions` WHERE `contestant_id` = ?", contestantID)
+	} else {
+		res, err = s.DB.Exec("DELETE FROM `sessions` WHERE `contestant_id` = ? AND `id` = ? LIMIT 1", contestantID, id)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("delete sessions: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+// Run は期限切れのセッションを ctx がキャンセルされるまで定期的に削除する。
+func (s *SessionStore) Run(ctx context.Context) {
+	ticker := time.NewTicker(SessionCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := s.DB.Exec("DELETE FROM `sessions` WHERE `expires_at` <= NOW(6)"); err != nil {
+			log.Printf("[WARN] delete expired sessions: %v", err)
+		}
+	}
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate session id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
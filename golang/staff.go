@@ -0,0 +1,61 @@
+package xsuportal
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// スタッフのロールは後ろほど強く、強いロールは弱いロールでできることを全部できる。
+const (
+	StaffRoleViewer                = "viewer"
+	StaffRoleClarificationAnswerer = "clarification_answerer"
+	StaffRoleContestOperator       = "contest_operator"
+)
+
+var StaffRoles = []string{
+	StaffRoleViewer,
+	StaffRoleClarificationAnswerer,
+	StaffRoleContestOperator,
+}
+
+func staffRoleRank(role string) int {
+	for i, r := range StaffRoles {
+		if r == role {
+			return i
+		}
+	}
+	return -1
+}
+
+func IsStaffRole(role string) bool {
+	return staffRoleRank(role) >= 0
+}
+
+// Can は s が role 以上のロールを持っているかを返す。
+func (s *Staff) Can(role string) bool {
+	return s != nil && staffRoleRank(role) >= 0 && staffRoleRank(s.Role) >= staffRoleRank(role)
+}
+
+// GetStaff はコンテスタントのスタッフ情報を返す。
+// contestants.staff が立っているのに staffs に行がない場合は viewer として扱う。
+func GetStaff(db sqlx.Queryer, contestant *Contestant) (*Staff, error) {
+	if contestant == nil || !contestant.Staff {
+		return nil, nil
+	}
+	var staff Staff
+	err := sqlx.Get(
+		db,
+		&staff,
+		"SELECT * FROM `staffs` WHERE `contestant_id` = ? LIMIT 1",
+		contestant.ID,
+	)
+	if err == sql.ErrNoRows {
+		return &Staff{ContestantID: contestant.ID, Role: StaffRoleViewer}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get staff: %w", err)
+	}
+	return &staff, nil
+}
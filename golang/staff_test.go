@@ -0,0 +1,28 @@
+package xsuportal
+
+import "testing"
+
+func TestStaffCan(t *testing.T) {
+	tests := []struct {
+		staff *Staff
+		role  string
+		want  bool
+	}{
+		{nil, StaffRoleViewer, false},
+		{&Staff{Role: StaffRoleViewer}, StaffRoleViewer, true},
+		{&Staff{Role: StaffRoleViewer}, StaffRoleClarificationAnswerer, false},
+		{&Staff{Role: StaffRoleViewer}, StaffRoleContestOperator, false},
+		{&Staff{Role: StaffRoleClarificationAnswerer}, StaffRoleViewer, true},
+		{&Staff{Role: StaffRoleClarificationAnswerer}, StaffRoleClarificationAnswerer, true},
+		{&Staff{Role: StaffRoleClarificationAnswerer}, StaffRoleContestOperator, false},
+		{&Staff{Role: StaffRoleContestOperator}, StaffRoleViewer, true},
+		{&Staff{Role: StaffRoleContestOperator}, StaffRoleContestOperator, true},
+		{&Staff{Role: StaffRoleContestOperator}, "root", false},
+		{&Staff{Role: "root"}, StaffRoleViewer, false},
+	}
+	for _, tt := range tests {
+		if got := tt.staff.Can(tt.role); got != tt.want {
+			t.Errorf("%+v.Can(%s) = %v, want %v", tt.staff, tt.role, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package xsuportal
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	LoginThrottleScopeContestant = "contestant"
+	LoginThrottleScopeIP         = "ip"
+)
+
+type LoginAttempt struct {
+	Scope        string       `db:"scope"`
+	Key          string       `db:"key"`
+	Failures     int          `db:"failures"`
+	LockedUntil  sql.NullTime `db:"locked_until"`
+	LastFailedAt time.Time    `db:"last_failed_at"`
+}
+
+type LoginThrottleKey struct {
+	Scope string
+	Key   string
+}
+
+// LoginThrottle はコンテスタント ID とクライアント IP ごとにログインの失敗を数える。
+// Threshold (IP は IPThreshold) 回目の失敗からは BaseLockout, 2*BaseLockout, ... と倍々に (MaxLockout まで) ロックする。
+// 1 つの IP から大勢がログインすることもあるので、IPThreshold は大きめにしておく。
+// 最後の失敗から Window 以上経つと失敗回数は数え直しになる。
+type LoginThrottle struct {
+	Threshold   int
+	IPThreshold int
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+	Window      time.Duration
+}
+
+func (t *LoginThrottle) lockout(scope string, failures int) time.Duration {
+	threshold := t.Threshold
+	if scope == LoginThrottleScopeIP {
+		threshold = t.IPThreshold
+	}
+	if failures < threshold {
+		return 0
+	}
+	lockout := t.BaseLockout << uint(failures-threshold)
+	if lockout <= 0 || lockout > t.MaxLockout {
+		return t.MaxLockout
+	}
+	return lockout
+}
+
+// Locked はいずれかのキーがロック中なら、ロックが解けるまでの時間を返す。
+func (t *LoginThrottle) Locked(db sqlx.Queryer, keys ...LoginThrottleKey) (time.Duration, error) {
+	var longest time.Duration
+	for _, key := range keys {
+		var remaining sql.NullInt64
+		err := sqlx.Get(
+			db,
+			&remaining,
+			"SELECT TIMESTAMPDIFF(MICROSECOND, NOW(6), `locked_until`) FROM `login_attempts` WHERE `scope` = ? AND `key` = ? AND `locked_until` > NOW(6) LIMIT 1",
+			key.Scope,
+			key.Key,
+		)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("get login attempt: %w", err)
+		}
+		if d := time.Duration(remaining.Int64) * time.Microsecond; d > longest {
+			longest = d
+		}
+	}
+	return longest, nil
+}
+
+// Fail は失敗を記録し、これによってロックされた時間を返す。
+func (t *LoginThrottle) Fail(db *sqlx.DB, keys ...LoginThrottleKey) (time.Duration, error) {
+	tx, err := db.Beginx()
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+	var longest time.Duration
+	for _, key := range keys {
+		var failures int
+		err := tx.Get(
+			&failures,
+			"SELECT IF(`last_failed_at` < TIMESTAMPADD(MICROSECOND, ?, NOW(6)), 0, `failures`) FROM `login_attempts` WHERE `scope` = ? AND `key` = ? LIMIT 1 FOR UPDATE",
+			-t.Window.Microseconds(),
+			key.Scope,
+			key.Key,
+		)
+		if err != nil && err != sql.ErrNoRows {
+			return 0, fmt.Errorf("get login attempt: %w", err)
+		}
+		failures++
+		lockout := t.lockout(key.Scope, failures)
+		var lockedUntil interface{}
+		if lockout > 0 {
+			lockedUntil = lockout.Microseconds()
+		}
+		_, err = tx.Exec(
+			"INSERT INTO `login_attempts` (`scope`, `key`, `failures`, `locked_until`, `last_failed_at`) VALUES (?, ?, ?, TIMESTAMPADD(MICROSECOND, ?, NOW(6)), NOW(6)) ON DUPLICATE KEY UPDATE `failures` = VALUES(`failures`), `locked_until` = VALUES(`locked_until`), `last_failed_at` = VALUES(`last_failed_at`)",
+			key.Scope,
+			key.Key,
+			failures,
+			lockedUntil,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("upsert login attempt: %w", err)
+		}
+		if lockout > longest {
+			longest = lockout
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return longest, nil
+}
+
+// Clear は失敗の記録とロックを消す。ログインに成功したときとスタッフが解除するときに使う。
+func (t *LoginThrottle) Clear(db sqlx.Execer, key LoginThrottleKey) (bool, error) {
+	res, err := db.Exec(
+		"DELETE FROM `login_attempts` WHERE `scope` = ? AND `key` = ? LIMIT 1",
+		key.Scope,
+		key.Key,
+	)
+	if err != nil {
+		return false, fmt.Errorf("delete login attempt: %w", err)
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
@@ -0,0 +1,39 @@
+package xsuportal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginThrottleLockout(t *testing.T) {
+	throttle := &LoginThrottle{
+		Threshold:   5,
+		IPThreshold: 50,
+		BaseLockout: 30 * time.Second,
+		MaxLockout:  15 * time.Minute,
+		Window:      time.Hour,
+	}
+	tests := []struct {
+		scope    string
+		failures int
+		want     time.Duration
+	}{
+		{LoginThrottleScopeContestant, 0, 0},
+		{LoginThrottleScopeContestant, 4, 0},
+		{LoginThrottleScopeContestant, 5, 30 * time.Second},
+		{LoginThrottleScopeContestant, 6, time.Minute},
+		{LoginThrottleScopeContestant, 9, 8 * time.Minute},
+		{LoginThrottleScopeContestant, 10, 15 * time.Minute},
+		{LoginThrottleScopeContestant, 100, 15 * time.Minute},
+		{LoginThrottleScopeIP, 5, 0},
+		{LoginThrottleScopeIP, 49, 0},
+		{LoginThrottleScopeIP, 50, 30 * time.Second},
+		{LoginThrottleScopeIP, 51, time.Minute},
+		{LoginThrottleScopeIP, 1000, 15 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := throttle.lockout(tt.scope, tt.failures); got != tt.want {
+			t.Errorf("lockout(%s, %d) = %v, want %v", tt.scope, tt.failures, got, tt.want)
+		}
+	}
+}
@@ -19,6 +19,7 @@ type Contestant struct {
 
 type Team struct {
 	ID           int64          `db:"id"`
+	ContestID    int64          `db:"contest_id"`
 	Name         string         `db:"name"`
 	LeaderID     sql.NullString `db:"leader_id"`
 	EmailAddress string         `db:"email_address"`
@@ -37,6 +38,7 @@ type JobResult struct {
 
 type Clarification struct {
 	ID         int64          `db:"id"`
+	ContestID  int64          `db:"contest_id"`
 	TeamID     int64          `db:"team_id"`
 	Disclosed  sql.NullBool   `db:"disclosed"`
 	Question   sql.NullString `db:"question"`
@@ -46,20 +48,30 @@ type Clarification struct {
 	UpdatedAt  time.Time      `db:"updated_at"`
 }
 
+type Contest struct {
+	ID                 int64        `db:"id"`
+	Name               string       `db:"name"`
+	RegistrationOpenAt time.Time    `db:"registration_open_at"`
+	ContestStartsAt    time.Time    `db:"contest_starts_at"`
+	ContestFreezesAt   time.Time    `db:"contest_freezes_at"`
+	ContestEndsAt      time.Time    `db:"contest_ends_at"`
+	Active             bool         `db:"active"`
+	ArchivedAt         sql.NullTime `db:"archived_at"`
+	CreatedAt          time.Time    `db:"created_at"`
+	UpdatedAt          time.Time    `db:"updated_at"`
+}
+
 type ContestStatus struct {
-	RegistrationOpenAt time.Time `db:"registration_open_at"`
-	ContestStartsAt    time.Time `db:"contest_starts_at"`
-	ContestFreezesAt   time.Time `db:"contest_freezes_at"`
-	ContestEndsAt      time.Time `db:"contest_ends_at"`
-	CurrentTime        time.Time `db:"current_time"`
-	StatusStr          string    `db:"status"`
-	Frozen             bool      `db:"frozen"`
-
-	Status resources.Contest_Status `db:"-"`
+	Contest
+
+	CurrentTime time.Time                `db:"-"`
+	Status      resources.Contest_Status `db:"-"`
+	Frozen      bool                     `db:"-"`
 }
 
 type BenchmarkJob struct {
 	ID             int64          `db:"id"`
+	ContestID      int64          `db:"contest_id"`
 	TeamID         int64          `db:"team_id"`
 	Status         int            `db:"status"`
 	TargetHostName string         `db:"target_hostname"`
@@ -70,12 +82,24 @@ type BenchmarkJob struct {
 	Passed         sql.NullBool   `db:"passed"`
 	StartedAt      sql.NullTime   `db:"started_at"`
 	FinishedAt     sql.NullTime   `db:"finished_at"`
+	DispatchedAt   sql.NullTime   `db:"dispatched_at"`
+	RequeueCount   int            `db:"requeue_count"`
 	CreatedAt      time.Time      `db:"created_at"`
 	UpdatedAt      time.Time      `db:"updated_at"`
 }
 
+type BenchmarkJobProgress struct {
+	ID             int64         `db:"id"`
+	BenchmarkJobID int64         `db:"benchmark_job_id"`
+	ScoreRaw       sql.NullInt32 `db:"score_raw"`
+	ScoreDeduction sql.NullInt32 `db:"score_deduction"`
+	MarkedAt       time.Time     `db:"marked_at"`
+	CreatedAt      time.Time     `db:"created_at"`
+}
+
 type Notification struct {
 	ID             int64     `db:"id"`
+	ContestID      int64     `db:"contest_id"`
 	ContestantID   string    `db:"contestant_id"`
 	Read           bool      `db:"read"`
 	EncodedMessage string    `db:"encoded_message"`
@@ -93,6 +117,37 @@ type PushSubscription struct {
 	UpdatedAt    time.Time `db:"updated_at"`
 }
 
+type Staff struct {
+	ID           int64          `db:"id"`
+	ContestantID string         `db:"contestant_id"`
+	GithubLogin  sql.NullString `db:"github_login"`
+	Role         string         `db:"role"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+type NotificationPreference struct {
+	ContestantID string    `db:"contestant_id"`
+	ContentType  string    `db:"content_type"`
+	InApp        bool      `db:"in_app"`
+	WebPush      bool      `db:"web_push"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+type OutboxEvent struct {
+	ID            int64          `db:"id"`
+	Kind          string         `db:"kind"`
+	Payload       string         `db:"payload"`
+	Attempts      int            `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	LockedUntil   sql.NullTime   `db:"locked_until"`
+	ProcessedAt   sql.NullTime   `db:"processed_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
 type LeaderBoardTeam struct {
 	ID                   int64          `db:"id"`
 	Name                 string         `db:"name"`
@@ -0,0 +1,22 @@
+#!/bin/bash
+# .proto から golang/proto 以下の Go コードと、frontend の pb.js / pb.d.ts を生成する。
+# protoc v3.14.0 と protoc-gen-go v1.25.0 を PATH に置き、frontend で yarn install を済ませてから実行すること。
+# bench の *_grpc.pb.go はサービス定義を変えたときだけ protoc-gen-go-grpc で作り直す。
+set -euo pipefail
+
+cd "$(dirname "$0")"
+
+FILES=$(find xsuportal -name '*.proto' | sort)
+# logout.proto の go_package は他と違うパスを指しているので、出力先は .proto の置き場所に合わせる
+protoc -I . \
+  --go_out=../golang/proto \
+  --go_opt=paths=source_relative,Mgoogle/protobuf/timestamp.proto=github.com/golang/protobuf/ptypes/timestamp \
+  $FILES
+
+PBJS=../frontend/node_modules/.bin/pbjs
+PBTS=../frontend/node_modules/.bin/pbts
+$PBJS -t static-module -w commonjs -o ../frontend/javascript/pb.js google/protobuf/timestamp.proto $FILES
+$PBTS -o ../frontend/javascript/pb.d.ts ../frontend/javascript/pb.js
+# Service Worker は通知の表示にしか使わないので notification.proto だけにする
+$PBJS -t static-module -w commonjs -o ../frontend/sw/src/pb.js google/protobuf/timestamp.proto xsuportal/resources/notification.proto
+$PBTS -o ../frontend/sw/src/pb.d.ts ../frontend/sw/src/pb.js
@@ -11,4 +11,14 @@ export MYSQL_PWD=isucon
 export LANG="C.UTF-8"
 cd $CURRENT_DIR
 
-cat setup.sql schema.sql | mysql --defaults-file=/dev/null -h $MYSQL_HOST -P $MYSQL_PORT -u $MYSQL_USER $MYSQL_DBNAME
\ No newline at end of file
+# 開発用に DB を作り直すスクリプトで、データは全て消える。
+# 動いている DB のスキーマを更新するときはこれを使わずに migrate up だけを実行すること。
+echo 'DROP DATABASE IF EXISTS `'$MYSQL_DBNAME'`;' | mysql --defaults-file=/dev/null -h $MYSQL_HOST -P $MYSQL_PORT -u $MYSQL_USER
+cat setup.sql | mysql --defaults-file=/dev/null -h $MYSQL_HOST -P $MYSQL_PORT -u $MYSQL_USER
+
+export MYSQL_HOSTNAME=$MYSQL_HOST
+export MYSQL_DATABASE=$MYSQL_DBNAME
+export MYSQL_PASS=$MYSQL_PWD
+export XSUPORTAL_MIGRATIONS_DIR=$CURRENT_DIR/migrations
+cd $CURRENT_DIR/../golang
+go run ./cmd/xsuportal migrate up
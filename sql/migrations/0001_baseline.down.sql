@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS `contest_config`;
+DROP TABLE IF EXISTS `push_subscriptions`;
+DROP TABLE IF EXISTS `notifications`;
+DROP TABLE IF EXISTS `clarifications`;
+DROP TABLE IF EXISTS `benchmark_jobs`;
+DROP TABLE IF EXISTS `teams`;
+DROP TABLE IF EXISTS `contestants`;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `notification_outbox` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `kind` VARCHAR(255) NOT NULL,
+  `payload` VARCHAR(255) NOT NULL,
+  `attempts` INT NOT NULL DEFAULT 0,
+  `last_error` VARCHAR(255),
+  `next_attempt_at` DATETIME(6) NOT NULL,
+  `locked_until` DATETIME(6),
+  `processed_at` DATETIME(6),
+  `created_at` DATETIME(6) NOT NULL,
+  `updated_at` DATETIME(6) NOT NULL,
+  INDEX idx_pending (`processed_at`, `next_attempt_at`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE `benchmark_jobs` DROP COLUMN `requeue_count`;
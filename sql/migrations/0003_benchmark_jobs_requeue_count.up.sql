@@ -0,0 +1 @@
+ALTER TABLE `benchmark_jobs` ADD COLUMN `requeue_count` INT NOT NULL DEFAULT 0 AFTER `finished_at`;
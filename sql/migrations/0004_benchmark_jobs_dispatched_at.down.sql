@@ -0,0 +1 @@
+ALTER TABLE `benchmark_jobs` DROP INDEX idx_dispatched_at, DROP COLUMN `dispatched_at`;
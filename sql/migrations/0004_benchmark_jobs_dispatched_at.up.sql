@@ -0,0 +1 @@
+ALTER TABLE `benchmark_jobs` ADD COLUMN `dispatched_at` DATETIME(6) AFTER `finished_at`, ADD INDEX idx_dispatched_at (`dispatched_at`);
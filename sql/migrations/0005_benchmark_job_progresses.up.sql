@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `benchmark_job_progresses` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `benchmark_job_id` BIGINT NOT NULL,
+  `score_raw` INT,
+  `score_deduction` INT,
+  `marked_at` DATETIME(6) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL,
+  INDEX idx_benchmark_job_id (`benchmark_job_id`, `id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
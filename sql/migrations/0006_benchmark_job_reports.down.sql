@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `benchmark_job_reports`;
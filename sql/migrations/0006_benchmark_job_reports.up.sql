@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS `benchmark_job_reports` (
+  `benchmark_job_id` BIGINT NOT NULL,
+  `nonce` BIGINT NOT NULL,
+  `created_at` DATETIME(6) NOT NULL,
+  PRIMARY KEY (`benchmark_job_id`, `nonce`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE `notifications` DROP INDEX idx_unread;
@@ -0,0 +1 @@
+ALTER TABLE `notifications` ADD INDEX idx_unread (`contestant_id`,`read`);
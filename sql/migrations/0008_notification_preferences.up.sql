@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `notification_preferences` (
+  `contestant_id` VARCHAR(255) NOT NULL,
+  `content_type` VARCHAR(255) NOT NULL,
+  `in_app` TINYINT(1) NOT NULL DEFAULT TRUE,
+  `web_push` TINYINT(1) NOT NULL DEFAULT TRUE,
+  `created_at` DATETIME(6) NOT NULL,
+  `updated_at` DATETIME(6) NOT NULL,
+  PRIMARY KEY (`contestant_id`, `content_type`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
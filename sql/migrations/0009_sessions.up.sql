@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `sessions` (
+  `id` VARCHAR(64) NOT NULL PRIMARY KEY,
+  `contestant_id` VARCHAR(255),
+  `data` BLOB NOT NULL,
+  `expires_at` DATETIME(6) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL,
+  `updated_at` DATETIME(6) NOT NULL,
+  INDEX idx_contestant_id (`contestant_id`),
+  INDEX idx_expires_at (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `login_attempts` (
+  `scope` VARCHAR(16) NOT NULL,
+  `key` VARCHAR(255) NOT NULL,
+  `failures` INT NOT NULL DEFAULT 0,
+  `locked_until` DATETIME(6),
+  `last_failed_at` DATETIME(6) NOT NULL,
+  PRIMARY KEY (`scope`, `key`),
+  INDEX idx_last_failed_at (`last_failed_at`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
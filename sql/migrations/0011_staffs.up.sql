@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `staffs` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `contestant_id` VARCHAR(255) NOT NULL,
+  `github_login` VARCHAR(255),
+  `role` VARCHAR(32) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL,
+  `updated_at` DATETIME(6) NOT NULL,
+  UNIQUE KEY (`contestant_id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS `rehearsal_clock` (
+  `id` INT NOT NULL PRIMARY KEY,
+  `offset_microseconds` BIGINT NOT NULL DEFAULT 0,
+  `fixed_at` DATETIME(6),
+  `updated_at` DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
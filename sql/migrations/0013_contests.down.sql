@@ -0,0 +1,17 @@
+-- アクティブなコンテストの日時を contest_config に戻す。他のコンテストのデータは contest_id がなくなるので混ざる。
+CREATE TABLE IF NOT EXISTS `contest_config` (
+  `registration_open_at` DATETIME(6) NOT NULL,
+  `contest_starts_at` DATETIME(6) NOT NULL,
+  `contest_freezes_at` DATETIME(6) NOT NULL,
+  `contest_ends_at` DATETIME(6) NOT NULL
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
+
+INSERT INTO `contest_config` (`registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`)
+  SELECT `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at` FROM `contests` WHERE `active` = TRUE LIMIT 1;
+
+ALTER TABLE `notifications` DROP INDEX idx1, DROP INDEX idx_unread, DROP COLUMN `contest_id`, ADD INDEX idx1 (`contestant_id`,`id`), ADD INDEX idx_unread (`contestant_id`,`read`);
+ALTER TABLE `clarifications` DROP INDEX idx_contest_id, DROP COLUMN `contest_id`;
+ALTER TABLE `benchmark_jobs` DROP INDEX idx_contest_id, DROP COLUMN `contest_id`;
+ALTER TABLE `teams` DROP INDEX idx_contest_id, DROP COLUMN `contest_id`;
+
+DROP TABLE IF EXISTS `contests`;
@@ -0,0 +1,34 @@
+-- contest_config の 1 行 (なければ Initialize と同じ日程) を default という名前のアクティブなコンテストとして引き継ぎ、
+-- 既存のチーム・ジョブ・質問・通知はすべてそのコンテストのものにする。
+CREATE TABLE IF NOT EXISTS `contests` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `name` VARCHAR(255) NOT NULL,
+  `registration_open_at` DATETIME(6) NOT NULL,
+  `contest_starts_at` DATETIME(6) NOT NULL,
+  `contest_freezes_at` DATETIME(6) NOT NULL,
+  `contest_ends_at` DATETIME(6) NOT NULL,
+  `active` TINYINT(1) NOT NULL DEFAULT FALSE,
+  `archived_at` DATETIME(6),
+  `created_at` DATETIME(6) NOT NULL,
+  `updated_at` DATETIME(6) NOT NULL,
+  INDEX idx_active (`active`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET=utf8mb4;
+
+INSERT INTO `contests` (`name`, `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`, `active`, `created_at`, `updated_at`)
+  SELECT 'default', `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`, TRUE, NOW(6), NOW(6) FROM `contest_config` LIMIT 1;
+-- contest_config が空 (initialize 前) でも contest_id を埋められるように、Initialize と同じ日程で作っておく
+INSERT INTO `contests` (`name`, `registration_open_at`, `contest_starts_at`, `contest_freezes_at`, `contest_ends_at`, `active`, `created_at`, `updated_at`)
+  SELECT 'default', NOW(6), TIMESTAMPADD(SECOND, 5, NOW(6)), TIMESTAMPADD(SECOND, 40, NOW(6)), TIMESTAMPADD(SECOND, 50, NOW(6)), TRUE, NOW(6), NOW(6) FROM DUAL
+  WHERE NOT EXISTS (SELECT 1 FROM `contests`);
+
+ALTER TABLE `teams` ADD COLUMN `contest_id` BIGINT NOT NULL AFTER `id`, ADD INDEX idx_contest_id (`contest_id`);
+ALTER TABLE `benchmark_jobs` ADD COLUMN `contest_id` BIGINT NOT NULL AFTER `id`, ADD INDEX idx_contest_id (`contest_id`, `status`);
+ALTER TABLE `clarifications` ADD COLUMN `contest_id` BIGINT NOT NULL AFTER `id`, ADD INDEX idx_contest_id (`contest_id`);
+ALTER TABLE `notifications` ADD COLUMN `contest_id` BIGINT NOT NULL AFTER `id`, DROP INDEX idx1, DROP INDEX idx_unread, ADD INDEX idx1 (`contestant_id`,`contest_id`,`id`), ADD INDEX idx_unread (`contestant_id`,`contest_id`,`read`);
+
+UPDATE `teams` SET `contest_id` = (SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1);
+UPDATE `benchmark_jobs` SET `contest_id` = (SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1);
+UPDATE `clarifications` SET `contest_id` = (SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1);
+UPDATE `notifications` SET `contest_id` = (SELECT `id` FROM `contests` WHERE `active` = TRUE LIMIT 1);
+
+DROP TABLE IF EXISTS `contest_config`;
@@ -0,0 +1,2 @@
+-- 複数のコンテストで同じ人がリーダーになっていると戻せない
+ALTER TABLE `teams` DROP INDEX idx_contest_leader, ADD UNIQUE KEY `leader_id` (`leader_id`);
@@ -0,0 +1,2 @@
+-- リーダーはコンテストごとに 1 チームなので、前のコンテストのリーダーも新しいコンテストでチームを作れるようにする
+ALTER TABLE `teams` DROP INDEX `leader_id`, ADD UNIQUE KEY idx_contest_leader (`contest_id`, `leader_id`);